@@ -77,6 +77,7 @@ func create(id clio.Identification) (clio.Application, *cobra.Command) {
 	// add sub-commands
 	rootCmd.AddCommand(
 		commands.DB(app),
+		commands.Policy(app),
 		commands.Completion(),
 		clio.VersionCommand(id, syftVersion, dbVersion),
 	)
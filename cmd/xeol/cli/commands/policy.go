@@ -0,0 +1,19 @@
+package commands
+
+import (
+	"github.com/anchore/clio"
+	"github.com/spf13/cobra"
+)
+
+func Policy(app clio.Application) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "policy operations",
+	}
+
+	cmd.AddCommand(
+		PolicyTest(app),
+	)
+
+	return cmd
+}
@@ -0,0 +1,357 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/syft/syft/source"
+	"github.com/karrick/tparse"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/xeol-io/xeol/cmd/xeol/cli/options"
+	"github.com/xeol-io/xeol/internal"
+	"github.com/xeol-io/xeol/internal/bus"
+	"github.com/xeol-io/xeol/xeol"
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/policy"
+	"github.com/xeol-io/xeol/xeol/policy/types"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+	"github.com/xeol-io/xeol/xeol/xeolerr"
+)
+
+type policyTestOptions struct {
+	Output      string `yaml:"output" json:"output" mapstructure:"output"`
+	AsOf        string `yaml:"as-of" json:"as-of" mapstructure:"as-of"`
+	Lookahead   string `yaml:"lookahead" json:"lookahead" mapstructure:"lookahead"`
+	ProjectName string `yaml:"project-name" json:"project-name" mapstructure:"project-name"`
+	DBOptions   `yaml:",inline" mapstructure:",squash"`
+}
+
+var _ interface {
+	clio.FlagAdder
+	clio.PostLoader
+} = (*policyTestOptions)(nil)
+
+func (o *policyTestOptions) AddFlags(flags clio.FlagSet) {
+	flags.StringVarP(&o.Output, "output", "o", "format to display results (available=[text, json])")
+	flags.StringVarP(&o.AsOf, "as-of", "", "the date (YYYY-MM-DD) to evaluate the policies at, today when not given")
+	flags.StringVarP(&o.Lookahead, "lookahead", "l",
+		"the lookahead (e.g. '1m', '1y') from the --as-of date, findings that are EOL within it are evaluated along with EOL findings",
+	)
+	flags.StringVarP(&o.ProjectName, "project-name", "", "the project name to evaluate project policies for, the project of the report when not given")
+}
+
+// PostLoad rejects an unsupported output format before the policies and findings are loaded
+func (o *policyTestOptions) PostLoad() error {
+	switch o.Output {
+	case "text", "json":
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format: %s", o.Output)
+	}
+}
+
+func PolicyTest(app clio.Application) *cobra.Command {
+	opts := &policyTestOptions{
+		Output:    "text",
+		Lookahead: "1y",
+		DBOptions: *dbOptionsDefault(app.ID()),
+	}
+
+	return app.SetupCommand(&cobra.Command{
+		Use:   "test [POLICY_FILE] [REPORT_OR_SBOM]",
+		Short: "dry-run policies against a saved xeol JSON report or an SBOM",
+		Long: `Evaluate the policies of a policy file (YAML or JSON) offline, against the findings of a previous
+xeol JSON report (-o json) or of an SBOM, and show which findings would WARN or DENY at the --as-of date.
+SBOMs are matched with the local EOL database. Notary policies are not evaluated, since verifying image
+signatures requires the registry.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runPolicyTest(app, opts, args[0], args[1])
+		},
+	}, opts)
+}
+
+// policyTestResult is a policy that fired, along with the findings it fired for
+type policyTestResult struct {
+	models.PolicyEvaluation
+	Packages []string `json:"packages"`
+}
+
+func runPolicyTest(app clio.Application, opts *policyTestOptions, policyPath, input string) error {
+	defer bus.Exit()
+
+	asOf := time.Now()
+	if opts.AsOf != "" {
+		var err error
+		asOf, err = time.Parse(eol.DateLayout, opts.AsOf)
+		if err != nil {
+			return fmt.Errorf("bad --as-of value (expected YYYY-MM-DD): '%s'", opts.AsOf)
+		}
+	}
+
+	statusCfg := match.StatusConfig{Now: asOf}
+	if opts.Lookahead != "" && opts.Lookahead != "none" {
+		end, err := tparse.AddDuration(asOf, "+"+opts.Lookahead)
+		if err != nil {
+			return fmt.Errorf("bad --lookahead value: '%s'", opts.Lookahead)
+		}
+		statusCfg.Windows = []match.Window{{Name: opts.Lookahead, End: end}}
+	}
+
+	policies, err := policy.LoadPolicies(policyPath)
+	if err != nil {
+		return err
+	}
+	policies = policy.MergePolicies(policies)
+
+	findings, evalCtx, err := policyTestFindings(app, opts, input)
+	if err != nil {
+		return err
+	}
+	if opts.ProjectName != "" {
+		evalCtx.ProjectName = opts.ProjectName
+	}
+	evalCtx.EvaluationTime = asOf
+
+	// the findings are classified as of the --as-of date, so that findings that are not EOL yet (or were
+	// not at the time of the scan) are evaluated the same way a scan at that date would
+	eolMatches := match.NewMatches()
+	for _, m := range findings {
+		m.Status, m.Window = statusCfg.Classify(m.Cycle)
+		if m.Status.IsEol() {
+			eolMatches.Add(m)
+		}
+	}
+
+	var failScan bool
+	var evaluations []types.PolicyEvaluationResult
+	for _, p := range policies {
+		if p.GetPolicyType() == types.PolicyTypeNotary {
+			_ = stderrPrintLnf("Skipping notary policy: image signatures cannot be verified offline")
+			continue
+		}
+		shouldFailScan, results := p.Evaluate(eolMatches, evalCtx)
+		if shouldFailScan {
+			failScan = true
+		}
+		evaluations = append(evaluations, results...)
+	}
+
+	if err := presentPolicyTest(os.Stdout, opts.Output, asOf, newPolicyTestResults(evaluations, eolMatches)); err != nil {
+		return err
+	}
+
+	if failScan {
+		return xeolerr.ErrPolicyViolation
+	}
+	return nil
+}
+
+// policyTestFindings returns the findings of a xeol JSON report, or the matches of the packages of an SBOM,
+// along with the context of the scanned source
+func policyTestFindings(app clio.Application, opts *policyTestOptions, input string) ([]match.Match, types.EvaluationContext, error) {
+	data, err := os.ReadFile(input)
+	if err != nil {
+		return nil, types.EvaluationContext{}, fmt.Errorf("unable to read %q: %w", input, err)
+	}
+
+	var r savedReport
+	if err := json.Unmarshal(data, &r); err == nil && r.Descriptor.Name == internal.ApplicationName {
+		return r.findings(), r.evaluationContext(), nil
+	}
+
+	packages, pkgContext, _, err := pkg.Provide("sbom:"+input, getProviderConfig(options.DefaultXeol(app.ID())))
+	if err != nil {
+		return nil, types.EvaluationContext{}, fmt.Errorf("%q is neither a xeol JSON report nor a SBOM: %w", input, err)
+	}
+
+	str, status, dbCloser, err := xeol.LoadEolDB(opts.DB.ToCuratorConfig(), false)
+	if err = validateDBLoad(err, status); err != nil {
+		return nil, types.EvaluationContext{}, err
+	}
+	if dbCloser != nil {
+		defer dbCloser.Close()
+	}
+
+	eolMatcher := xeol.EolMatcher{
+		Store:        *str,
+		Matchers:     getMatchers(options.DefaultXeol(app.ID())),
+		Statuses:     match.InventoryStatuses,
+		LinuxRelease: pkgContext.Distro,
+	}
+	matches, _, err := eolMatcher.FindEol(packages)
+	if err != nil && !errors.Is(err, xeolerr.ErrEolFound) {
+		return nil, types.EvaluationContext{}, err
+	}
+
+	var evalCtx types.EvaluationContext
+	if pkgContext.Source != nil {
+		if metadata, ok := pkgContext.Source.Metadata.(source.ImageMetadata); ok {
+			evalCtx.ImageReference = metadata.UserInput
+			evalCtx.ImageLabels = metadata.Labels
+		}
+	}
+	return matches.Sorted(), evalCtx, nil
+}
+
+// savedReport is the part of a xeol JSON report (-o json) needed to evaluate policies
+type savedReport struct {
+	Matches []models.Match `json:"matches"`
+	Source  *struct {
+		Type   string          `json:"type"`
+		Target json.RawMessage `json:"target"`
+	} `json:"source"`
+	Descriptor struct {
+		Name          string `json:"name"`
+		Configuration struct {
+			ProjectName string `json:"project-name"`
+		} `json:"configuration"`
+	} `json:"descriptor"`
+}
+
+func (r savedReport) findings() []match.Match {
+	findings := make([]match.Match, 0, len(r.Matches))
+	for i, m := range r.Matches {
+		cycle := eol.Cycle{
+			ProductName:       m.Cycle.ProductName,
+			ProductPermalink:  m.Cycle.ProductPermalink,
+			ReleaseCycle:      m.Cycle.ReleaseCycle,
+			LatestRelease:     m.Cycle.LatestRelease,
+			LatestReleaseDate: m.Cycle.LatestReleaseDate,
+			ReleaseDate:       m.Cycle.ReleaseDate,
+		}
+		// reports show cycles without an EOL date with their EOL boolean instead
+		switch m.Cycle.Eol {
+		case "true":
+			cycle.EolBool = true
+		case "false":
+		default:
+			cycle.Eol = m.Cycle.Eol
+		}
+
+		findings = append(findings, match.Match{
+			Cycle: cycle,
+			Package: pkg.Package{
+				ID:       pkg.ID(strconv.Itoa(i)),
+				Name:     m.Artifact.Name,
+				Version:  m.Artifact.Version,
+				Type:     m.Artifact.Type,
+				Language: m.Artifact.Language,
+				PURL:     m.Artifact.PURL,
+			},
+		})
+	}
+	return findings
+}
+
+func (r savedReport) evaluationContext() types.EvaluationContext {
+	evalCtx := types.EvaluationContext{
+		ProjectName: r.Descriptor.Configuration.ProjectName,
+	}
+	if r.Source == nil || r.Source.Type != "image" {
+		return evalCtx
+	}
+
+	var target struct {
+		UserInput string            `json:"userInput"`
+		Labels    map[string]string `json:"labels"`
+	}
+	if err := json.Unmarshal(r.Source.Target, &target); err == nil {
+		evalCtx.ImageReference = target.UserInput
+		evalCtx.ImageLabels = target.Labels
+	}
+	return evalCtx
+}
+
+func newPolicyTestResults(evaluations []types.PolicyEvaluationResult, matches match.Matches) []policyTestResult {
+	// the packages of every product cycle, as policies fire once per cycle
+	packages := make(map[string][]string)
+	for _, m := range matches.Sorted() {
+		key := m.Cycle.ProductName + "@" + m.Cycle.ReleaseCycle
+		name := fmt.Sprintf("%s@%s", m.Package.Name, m.Package.Version)
+		if !slices.Contains(packages[key], name) {
+			packages[key] = append(packages[key], name)
+		}
+	}
+
+	results := make([]policyTestResult, 0, len(evaluations))
+	for _, e := range models.NewPolicyEvaluations(evaluations) {
+		pkgs := packages[e.ProductName+"@"+e.Cycle]
+		sort.Strings(pkgs)
+		results = append(results, policyTestResult{PolicyEvaluation: e, Packages: pkgs})
+	}
+	return results
+}
+
+func presentPolicyTest(output io.Writer, format string, asOf time.Time, results []policyTestResult) error {
+	switch format {
+	case "text":
+		if len(results) == 0 {
+			_, err := fmt.Fprintf(output, "✅ no policy would warn or deny as of %s\n", asOf.Format(eol.DateLayout))
+			return err
+		}
+
+		table := tablewriter.NewWriter(output)
+		table.SetHeader([]string{"ACTION", "TYPE", "POLICY", "SCOPE", "PRODUCT", "CYCLE", "FAIL DATE", "PACKAGES"})
+		table.SetAutoWrapText(false)
+		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+
+		table.SetHeaderLine(false)
+		table.SetBorder(false)
+		table.SetAutoFormatHeaders(true)
+		table.SetCenterSeparator("")
+		table.SetColumnSeparator("")
+		table.SetRowSeparator("")
+		table.SetTablePadding("  ")
+		table.SetNoWhiteSpace(true)
+
+		for _, r := range results {
+			table.Append([]string{
+				r.Action,
+				r.Type,
+				orDash(r.PolicyID),
+				orDash(r.PolicyScope),
+				orDash(r.ProductName),
+				orDash(r.Cycle),
+				orDash(r.FailDate),
+				orDash(strings.Join(r.Packages, ", ")),
+			})
+		}
+		table.Render()
+		return nil
+	case "json":
+		enc := json.NewEncoder(output)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", " ")
+		return enc.Encode(struct {
+			AsOf              string             `json:"asOf"`
+			PolicyEvaluations []policyTestResult `json:"policyEvaluations"`
+		}{
+			AsOf:              asOf.Format(eol.DateLayout),
+			PolicyEvaluations: results,
+		})
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
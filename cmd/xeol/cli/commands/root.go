@@ -22,6 +22,7 @@ import (
 	"github.com/wagoodman/go-partybus"
 
 	"github.com/xeol-io/xeol/cmd/xeol/cli/options"
+	"github.com/xeol-io/xeol/cmd/xeol/internal/ui"
 	"github.com/xeol-io/xeol/internal"
 	"github.com/xeol-io/xeol/internal/bus"
 	"github.com/xeol-io/xeol/internal/format"
@@ -32,6 +33,7 @@ import (
 	"github.com/xeol-io/xeol/xeol/db"
 	"github.com/xeol-io/xeol/xeol/event"
 	"github.com/xeol-io/xeol/xeol/event/parsers"
+	"github.com/xeol-io/xeol/xeol/match"
 	"github.com/xeol-io/xeol/xeol/matcher"
 	distroMatcher "github.com/xeol-io/xeol/xeol/matcher/distro"
 	pkgMatcher "github.com/xeol-io/xeol/xeol/matcher/packages"
@@ -77,6 +79,12 @@ You can also pipe in Syft JSON directly:
 		Args:          validateRootArgs,
 		SilenceUsage:  true,
 		SilenceErrors: true,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if format.StreamsToStdout(opts.Outputs, opts.File) {
+				disableUI(app)
+			}
+			return nil
+		},
 		RunE: func(_ *cobra.Command, args []string) error {
 			userInput := ""
 			if len(args) > 0 {
@@ -88,6 +96,17 @@ You can also pipe in Syft JSON directly:
 	}, opts)
 }
 
+// disableUI replaces the UI with one that only writes the final reports and notifications, for outputs that write
+// to the terminal while the scan is still running. The application UIs are set up before the command PreRunE runs.
+func disableUI(app clio.Application) {
+	a, ok := app.(interface{ State() *clio.State })
+	if !ok {
+		return
+	}
+	state := a.State()
+	state.UIs = []clio.UI{ui.None(state.Config.Log.Quiet)}
+}
+
 //nolint:funlen,gocognit
 func runXeol(app clio.Application, opts *options.Xeol, userInput string) error {
 	errs := make(chan error)
@@ -95,7 +114,11 @@ func runXeol(app clio.Application, opts *options.Xeol, userInput string) error {
 		defer close(errs)
 		defer bus.Exit()
 
-		writer, err := format.MakeScanResultWriter(opts.Outputs, opts.File)
+		writer, err := format.MakeScanResultWriter(opts.Outputs, opts.File, format.PresentationConfig{
+			TemplateFilePath: opts.OutputTemplateFile,
+			JUnitSkipPassing: opts.JUnitSkipPassing,
+			Columns:          opts.Columns,
+		})
 		if err != nil {
 			errs <- err
 			return
@@ -175,10 +198,17 @@ func runXeol(app clio.Application, opts *options.Xeol, userInput string) error {
 			Matchers:       getMatchers(opts),
 			FailOnEolFound: opts.FailOnEolFound,
 			EolMatchDate:   opts.EolMatchDate,
+			Windows:        opts.Windows,
+			Statuses:       opts.Statuses,
+			Workers:        opts.Match.Workers,
+			IgnoreRules:    opts.IgnoreRules,
 			LinuxRelease:   pkgContext.Distro,
 		}
 
-		allMatches, err := eolMatcher.FindEol(packages)
+		// local policies are evaluated along with (and take part in the same evaluation as) xeol.io policies
+		policies = policy.MergePolicies(opts.Policies, policies)
+
+		allMatches, ignoredMatches, err := findEol(eolMatcher, packages, writer, opts.APIKey != "" || len(policies) > 0)
 		if err != nil {
 			errs <- err
 			if !errors.Is(err, xeolerr.ErrEolFound) {
@@ -186,11 +216,21 @@ func runXeol(app clio.Application, opts *options.Xeol, userInput string) error {
 			}
 		}
 
+		// policies and xeol.io only consider EOL matches, regardless of the statuses reported. Ignored matches are
+		// not part of the matches.
+		eolMatches := allMatches.Eol()
+
 		var failScan bool
-		var imageVerified bool
 		var sourceIsImageType bool
-		if _, ok := s.Source.Metadata.(source.ImageMetadata); ok {
+		var policyEvaluations, notaryEvaluations []types.PolicyEvaluationResult
+		evalCtx := types.EvaluationContext{
+			ProjectName: opts.ProjectName,
+		}
+		if metadata, ok := s.Source.Metadata.(source.ImageMetadata); ok {
 			sourceIsImageType = true
+			evalCtx.ImageReference = userInput
+			evalCtx.ImageLabels = metadata.Labels
+			evalCtx.CertificatesPEM = certificates
 		}
 
 		for _, p := range policies {
@@ -200,17 +240,19 @@ func runXeol(app clio.Application, opts *options.Xeol, userInput string) error {
 				if !sourceIsImageType {
 					continue
 				}
-				shouldFailScan, res := p.Evaluate(allMatches, opts.ProjectName, userInput, certificates)
-				imageVerified = res.GetVerified()
+				shouldFailScan, results := p.Evaluate(eolMatches, evalCtx)
+				notaryEvaluations = append(notaryEvaluations, results...)
 				if shouldFailScan {
 					failScan = true
 				}
+				policyEvaluations = append(policyEvaluations, results...)
 
-			case types.PolicyTypeEol:
-				shouldFailScan, _ := p.Evaluate(allMatches, opts.ProjectName, "", "")
+			case types.PolicyTypeEol, types.PolicyTypeExpression:
+				shouldFailScan, results := p.Evaluate(eolMatches, evalCtx)
 				if shouldFailScan {
 					failScan = true
 				}
+				policyEvaluations = append(policyEvaluations, results...)
 			}
 		}
 
@@ -230,12 +272,12 @@ func runXeol(app clio.Application, opts *options.Xeol, userInput string) error {
 			}
 
 			if err := x.SendEvent(report.XeolEventPayload{
-				Matches:       allMatches.Sorted(),
+				Matches:       eolMatches.Sorted(),
 				Packages:      packages,
 				Context:       pkgContext,
 				AppConfig:     opts,
 				EventSource:   eventSource,
-				ImageVerified: imageVerified,
+				ImageVerified: allVerified(notaryEvaluations),
 				Sbom:          base64.StdEncoding.EncodeToString(buf.Bytes()),
 			}); err != nil {
 				errs <- fmt.Errorf("failed to send eol event: %w", err)
@@ -244,13 +286,16 @@ func runXeol(app clio.Application, opts *options.Xeol, userInput string) error {
 		}
 
 		if err := writer.Write(models.PresenterConfig{
-			Matches:       allMatches,
-			Packages:      packages,
-			Context:       pkgContext,
-			SBOM:          s,
-			AppConfig:     opts,
-			ShowVulnCount: opts.ShowVulnCount,
-			DBStatus:      status,
+			Matches:           allMatches,
+			IgnoredMatches:    ignoredMatches,
+			PolicyEvaluations: policyEvaluations,
+			Packages:          packages,
+			Context:           pkgContext,
+			SBOM:              s,
+			AppConfig:         opts,
+			ShowVulnCount:     opts.ShowVulnCount,
+			ShowAll:           opts.ShowAll,
+			DBStatus:          status,
 		}); err != nil {
 			errs <- err
 		}
@@ -264,6 +309,42 @@ func runXeol(app clio.Application, opts *options.Xeol, userInput string) error {
 	return readAllErrors(errs)
 }
 
+// findEol finds the EOL matches of the packages, writing every match to the streaming outputs as soon as it
+// is found. The matches are only collected when something other than a streaming output needs them. Matches
+// waived by ignore rules are never written to the streaming outputs, and are always returned.
+func findEol(eolMatcher xeol.EolMatcher, packages []pkg.Package, writer format.ScanResultWriter, needMatches bool) (match.Matches, []match.IgnoredMatch, error) {
+	matchWriter, ok := writer.(format.MatchWriter)
+	if !ok {
+		return eolMatcher.FindEol(packages)
+	}
+
+	collect := needMatches || !matchWriter.StreamsAllMatches()
+	allMatches := match.NewMatches()
+	_, ignoredMatches, err := eolMatcher.StreamEol(packages, func(m match.Match) {
+		if err := matchWriter.WriteMatch(m); err != nil {
+			log.Warnf("unable to write match: %+v", err)
+		}
+		if collect {
+			allMatches.Add(m)
+		}
+	})
+	return allMatches, ignoredMatches, err
+}
+
+// allVerified returns whether the image was verified by every notary policy evaluation, and false when no notary
+// policy was evaluated
+func allVerified(results []types.PolicyEvaluationResult) bool {
+	if len(results) == 0 {
+		return false
+	}
+	for _, res := range results {
+		if !res.GetVerified() {
+			return false
+		}
+	}
+	return true
+}
+
 func readAllErrors(errs <-chan error) (out error) {
 	for {
 		if errs == nil {
@@ -359,6 +440,9 @@ func getProviderConfig(opts *options.Xeol) pkg.ProviderConfig {
 		).WithAdditions(
 			"alpm-db-cataloger",
 			"apk-db-cataloger",
+			// runtimes installed from tarballs (e.g. /usr/local/bin/python3.8) have no OS package, the
+			// binary classifiers are the only way to find them, also when scanning directories
+			"binary-classifier-cataloger",
 			"cargo-auditable-binary-cataloger",
 			"cocoapods-cataloger",
 			"conan-cataloger",
@@ -0,0 +1,42 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+)
+
+// ignoreRule is the config representation of a match.IgnoreRule. YAML decodes unquoted dates (until: 2025-01-31)
+// as timestamps, so until accepts both timestamps and strings.
+type ignoreRule struct {
+	Product  string `yaml:"product" json:"product" mapstructure:"product"`
+	Cycle    string `yaml:"cycle" json:"cycle" mapstructure:"cycle"`
+	Purl     string `yaml:"purl" json:"purl" mapstructure:"purl"`
+	Type     string `yaml:"type" json:"type" mapstructure:"type"`
+	Location string `yaml:"location" json:"location" mapstructure:"location"`
+	Reason   string `yaml:"reason" json:"reason" mapstructure:"reason"`
+	Until    any    `yaml:"until" json:"until" mapstructure:"until"`
+}
+
+func (r ignoreRule) toMatchRule() (match.IgnoreRule, error) {
+	rule := match.IgnoreRule{
+		Product:  r.Product,
+		Cycle:    r.Cycle,
+		Purl:     r.Purl,
+		Type:     r.Type,
+		Location: r.Location,
+		Reason:   r.Reason,
+	}
+	switch until := r.Until.(type) {
+	case nil:
+	case string:
+		rule.Until = until
+	case time.Time:
+		rule.Until = until.Format(eol.DateLayout)
+	default:
+		return rule, fmt.Errorf("ignore rule %s has an invalid until date %v (expected YYYY-MM-DD)", rule, until)
+	}
+	return rule, nil
+}
@@ -0,0 +1,53 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIgnoreRuleToMatchRule(t *testing.T) {
+	tests := []struct {
+		name      string
+		until     any
+		expected  string
+		wantError bool
+	}{
+		{
+			name:     "no until date",
+			until:    nil,
+			expected: "",
+		},
+		{
+			name:     "quoted date",
+			until:    "2025-01-31",
+			expected: "2025-01-31",
+		},
+		{
+			name:     "unquoted date decoded as a timestamp",
+			until:    time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC),
+			expected: "2025-01-31",
+		},
+		{
+			name:      "number",
+			until:     20250131,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ignoreRule{Product: "Python", Reason: "legacy", Until: tt.until}.toMatchRule()
+			if tt.wantError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Python", rule.Product)
+			assert.Equal(t, "legacy", rule.Reason)
+			assert.Equal(t, tt.expected, rule.Until)
+		})
+	}
+}
@@ -1,13 +1,21 @@
 package options
 
+import (
+	"fmt"
+
+	"github.com/anchore/clio"
+)
+
 // matchConfig contains all matching-related configuration options available to the user via the application config.
 type matchConfig struct {
-	Packages pkgMatcherConfig    `mapstructure:"packages"` // settings for the packages matcher
-	Distro   distroMatcherConfig `mapstructure:"distro"`   // settings for the distro matcher
+	Packages pkgMatcherConfig    `mapstructure:"packages"`                              // settings for the packages matcher
+	Distro   distroMatcherConfig `mapstructure:"distro"`                                // settings for the distro matcher
+	Workers  int                 `yaml:"workers" json:"workers" mapstructure:"workers"` // number of packages matched concurrently (default is the number of CPUs)
 }
 
 type pkgMatcherConfig struct {
 	UsePURLs bool `yaml:"using-purls" json:"using-purls" mapstructure:"using-purls"` // if Purls should be used during matching
+	UseCPEs  bool `yaml:"using-cpes" json:"using-cpes" mapstructure:"using-cpes"`    // if CPEs should be used when matching by Purl fails
 }
 
 type distroMatcherConfig struct {
@@ -16,9 +24,20 @@ type distroMatcherConfig struct {
 
 func defaultMatchConfig() matchConfig {
 	useCpe := distroMatcherConfig{UseCPEs: true}
-	usePurl := pkgMatcherConfig{UsePURLs: true}
+	usePurl := pkgMatcherConfig{UsePURLs: true, UseCPEs: true}
 	return matchConfig{
 		Packages: usePurl,
 		Distro:   useCpe,
 	}
 }
+
+func (cfg *matchConfig) PostLoad() error {
+	if cfg.Workers < 0 {
+		return fmt.Errorf("bad workers value %d: must not be negative", cfg.Workers)
+	}
+	return nil
+}
+
+func (cfg *matchConfig) DescribeFields(descriptions clio.FieldDescriptionSet) {
+	descriptions.Add(&cfg.Workers, `number of packages matched concurrently (same as --workers), the number of CPUs when 0`)
+}
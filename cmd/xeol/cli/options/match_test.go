@@ -0,0 +1,32 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchConfigPostLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		workers int
+		wantErr bool
+	}{
+		{name: "number of CPUs", workers: 0},
+		{name: "fixed number of workers", workers: 4},
+		{name: "negative number of workers", workers: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := defaultMatchConfig()
+			cfg.Workers = tt.workers
+			err := cfg.PostLoad()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package options
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/anchore/clio"
@@ -10,30 +12,46 @@ import (
 	"github.com/karrick/tparse"
 
 	"github.com/xeol-io/xeol/internal/format"
+	"github.com/xeol-io/xeol/internal/log"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/policy"
+	"github.com/xeol-io/xeol/xeol/presenter/csv"
 )
 
 const DefaultProLookahead = "now+3y"
 
 type Xeol struct {
-	Outputs                []string    `yaml:"output" json:"output" mapstructure:"output"`                                           // -o, <presenter>=<file> the Presenter hint string to use for report formatting and the output file
-	File                   string      `yaml:"file" json:"file" mapstructure:"file"`                                                 // --file, the file to write report output to
-	Distro                 string      `yaml:"distro" json:"distro" mapstructure:"distro"`                                           // --distro, specify a distro to explicitly use
-	CheckForAppUpdate      bool        `yaml:"check-for-app-update" json:"check-for-app-update" mapstructure:"check-for-app-update"` // whether to check for an application update on start up or not
-	Platform               string      `yaml:"platform" json:"platform" mapstructure:"platform"`                                     // --platform, override the target platform for a container image
-	Search                 search      `yaml:"search" json:"search" mapstructure:"search"`
-	DB                     Database    `yaml:"db" json:"db" mapstructure:"db"`
-	Lookahead              string      `yaml:"lookahead" json:"lookahead" mapstructure:"lookahead"`
-	EolMatchDate           time.Time   `yaml:"-" json:"-"`
-	FailOnEolFound         bool        `yaml:"fail-on-eol-found" json:"fail-on-eol-found" mapstructure:"fail-on-eol-found"` // whether to exit with a non-zero exit code if any EOLs are found
-	APIKey                 string      `yaml:"api-key" json:"api-key" mapstructure:"api-key"`
-	ProjectName            string      `yaml:"project-name" json:"project-name" mapstructure:"project-name"`
-	ImagePath              string      `yaml:"image-path" json:"image-path" mapstructure:"image-path"`
-	CommitHash             string      `yaml:"commit-hash" json:"commit-hash" mapstructure:"commit-hash"`
-	Match                  matchConfig `yaml:"match" json:"match" mapstructure:"match"`
-	Registry               registry    `yaml:"registry" json:"registry" mapstructure:"registry"`
-	Name                   string      `yaml:"name" json:"name" mapstructure:"name"`
-	DefaultImagePullSource string      `yaml:"default-image-pull-source" json:"default-image-pull-source" mapstructure:"default-image-pull-source"`
-	ShowVulnCount          bool        `yaml:"show-vuln-count" json:"show-vuln-count" mapstructure:"show-vuln-count"`
+	Outputs                []string           `yaml:"output" json:"output" mapstructure:"output"`                                           // -o, <presenter>=<file> the Presenter hint string to use for report formatting and the output file
+	File                   string             `yaml:"file" json:"file" mapstructure:"file"`                                                 // --file, the file to write report output to
+	OutputTemplateFile     string             `yaml:"output-template-file" json:"output-template-file" mapstructure:"output-template-file"` // -t, the template file to use for formatting the final report
+	Distro                 string             `yaml:"distro" json:"distro" mapstructure:"distro"`                                           // --distro, specify a distro to explicitly use
+	CheckForAppUpdate      bool               `yaml:"check-for-app-update" json:"check-for-app-update" mapstructure:"check-for-app-update"` // whether to check for an application update on start up or not
+	Platform               string             `yaml:"platform" json:"platform" mapstructure:"platform"`                                     // --platform, override the target platform for a container image
+	Search                 search             `yaml:"search" json:"search" mapstructure:"search"`
+	DB                     Database           `yaml:"db" json:"db" mapstructure:"db"`
+	Lookahead              string             `yaml:"lookahead" json:"lookahead" mapstructure:"lookahead"`
+	EolMatchDate           time.Time          `yaml:"-" json:"-"`
+	FailOnEolFound         bool               `yaml:"fail-on-eol-found" json:"fail-on-eol-found" mapstructure:"fail-on-eol-found"` // whether to exit with a non-zero exit code if any EOLs are found
+	APIKey                 string             `yaml:"api-key" json:"api-key" mapstructure:"api-key"`
+	ProjectName            string             `yaml:"project-name" json:"project-name" mapstructure:"project-name"`
+	ImagePath              string             `yaml:"image-path" json:"image-path" mapstructure:"image-path"`
+	CommitHash             string             `yaml:"commit-hash" json:"commit-hash" mapstructure:"commit-hash"`
+	Match                  matchConfig        `yaml:"match" json:"match" mapstructure:"match"`
+	Registry               registry           `yaml:"registry" json:"registry" mapstructure:"registry"`
+	Name                   string             `yaml:"name" json:"name" mapstructure:"name"`
+	DefaultImagePullSource string             `yaml:"default-image-pull-source" json:"default-image-pull-source" mapstructure:"default-image-pull-source"`
+	ShowVulnCount          bool               `yaml:"show-vuln-count" json:"show-vuln-count" mapstructure:"show-vuln-count"`
+	JUnitSkipPassing       bool               `yaml:"junit-skip-passing" json:"junit-skip-passing" mapstructure:"junit-skip-passing"` // report packages without EOL findings as skipped test cases in the junit output
+	Columns                []string           `yaml:"columns" json:"columns" mapstructure:"columns"`                                  // --columns, the columns (and their order) of the csv and tsv outputs
+	EolWindows             []string           `yaml:"eol-windows" json:"eol-windows" mapstructure:"eol-windows"`                      // --eol-window, windows (besides the lookahead) to report cycles that become EOL in
+	Windows                []match.Window     `yaml:"-" json:"-"`
+	Status                 []string           `yaml:"status" json:"status" mapstructure:"status"` // --status, the lifecycle statuses of the matches to report
+	Statuses               []match.Status     `yaml:"-" json:"-"`
+	ShowAll                bool               `yaml:"show-all" json:"show-all" mapstructure:"show-all"` // --show-all, report every package that resolves to a cycle, not only EOL packages
+	Policy                 []string           `yaml:"policy" json:"policy" mapstructure:"policy"`       // --policy, local policy files (YAML or JSON), evaluated along with xeol.io policies
+	Policies               []policy.Policy    `yaml:"-" json:"-"`
+	Ignore                 []ignoreRule       `yaml:"ignore" json:"ignore" mapstructure:"ignore"` // rules to waive matches, along with the reason and an optional (inclusive) until date
+	IgnoreRules            []match.IgnoreRule `yaml:"-" json:"-"`
 }
 
 var _ interface {
@@ -91,6 +109,10 @@ func (o *Xeol) AddFlags(flags clio.FlagSet) {
 		"file to write the default report output to (default is STDOUT)",
 	)
 
+	flags.StringVarP(&o.OutputTemplateFile,
+		"template", "t",
+		"specify the path to a Go template file (requires 'template' output to be selected)")
+
 	flags.StringVarP(&o.Name,
 		"name", "",
 		"set the name of the target being analyzed",
@@ -130,6 +152,41 @@ func (o *Xeol) AddFlags(flags clio.FlagSet) {
 		"show-vuln-count", "",
 		"show the number of vulnerabilities found for each package (default is false)",
 	)
+
+	flags.BoolVarP(&o.JUnitSkipPassing,
+		"junit-skip-passing", "",
+		"report packages that are not EOL (or only EOL within the lookahead window) as skipped instead of passing test cases in the 'junit' output",
+	)
+
+	flags.StringArrayVarP(&o.EolWindows,
+		"eol-window", "",
+		"an additional window to report packages that become EOL within, besides the lookahead (e.g. '30d', '90d', '1y'). Can be given multiple times or as a comma-separated list",
+	)
+
+	flags.StringArrayVarP(&o.Status,
+		"status", "",
+		fmt.Sprintf("comma-separated list of the lifecycle statuses of the packages to report, statuses=%v", match.AllStatuses),
+	)
+
+	flags.BoolVarP(&o.ShowAll,
+		"show-all", "",
+		"report every package that resolves to a lifecycle cycle, including supported packages and their remaining days of support",
+	)
+
+	flags.IntVarP(&o.Match.Workers,
+		"workers", "",
+		"number of packages matched concurrently, the number of CPUs when 0",
+	)
+
+	flags.StringArrayVarP(&o.Policy,
+		"policy", "",
+		"a local policy file (YAML or JSON) to evaluate, in the same format as xeol.io policies. Can be given multiple times, and is merged with the xeol.io policies when an API key is set",
+	)
+
+	flags.StringArrayVarP(&o.Columns,
+		"columns", "",
+		fmt.Sprintf("comma-separated list of the columns to include, in order, in the 'csv' and 'tsv' outputs, columns=%v", csv.AllColumns),
+	)
 }
 
 func (o *Xeol) parseLookaheadOption() (err error) {
@@ -156,6 +213,142 @@ func (o *Xeol) parseLookaheadOption() (err error) {
 	return nil
 }
 
+// parseWindowsOption creates the windows used to classify packages that become EOL in the future: the lookahead
+// window and any additional windows
+func (o *Xeol) parseWindowsOption() error {
+	now := time.Now()
+	lookahead := o.Lookahead
+	if o.APIKey != "" {
+		lookahead = strings.TrimPrefix(DefaultProLookahead, "now+")
+	}
+
+	var windows []match.Window
+	seen := make(map[string]bool)
+	if o.EolMatchDate.After(now) {
+		windows = append(windows, match.Window{Name: lookahead, End: o.EolMatchDate})
+		seen[lookahead] = true
+	}
+
+	for _, spec := range splitList(o.EolWindows) {
+		if seen[spec] {
+			continue
+		}
+		seen[spec] = true
+
+		end, err := tparse.ParseNow(time.RFC3339, fmt.Sprintf("now+%s", spec))
+		if err != nil {
+			return fmt.Errorf("bad --eol-window value: '%s'", spec)
+		}
+		windows = append(windows, match.Window{Name: spec, End: end})
+	}
+	o.Windows = windows
+	return nil
+}
+
+func (o *Xeol) parseStatusOption() error {
+	o.Statuses = nil
+	for _, name := range splitList(o.Status) {
+		status, err := match.ParseStatus(name)
+		if err != nil {
+			return fmt.Errorf("bad --status value: %w", err)
+		}
+		o.Statuses = append(o.Statuses, status)
+	}
+
+	if !o.ShowAll {
+		return nil
+	}
+	// --show-all extends the configured statuses with the statuses of every package that resolves to a cycle
+	for _, status := range match.InventoryStatuses {
+		if !slices.Contains(o.Statuses, status) {
+			o.Statuses = append(o.Statuses, status)
+		}
+	}
+	return nil
+}
+
+// splitList flattens values that may each hold a comma-separated list, dropping empty entries
+func splitList(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				out = append(out, item)
+			}
+		}
+	}
+	return out
+}
+
+func (o *Xeol) parsePolicyOption() error {
+	o.Policies = nil
+	for _, path := range splitList(o.Policy) {
+		policies, err := policy.LoadPolicies(path)
+		if err != nil {
+			return fmt.Errorf("bad --policy value: %w", err)
+		}
+		o.Policies = append(o.Policies, policies...)
+	}
+	return nil
+}
+
+func (o *Xeol) parseIgnoreOption() error {
+	now := time.Now()
+	o.IgnoreRules = nil
+	for _, r := range o.Ignore {
+		rule, err := r.toMatchRule()
+		if err != nil {
+			return fmt.Errorf("bad ignore value: %w", err)
+		}
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("bad ignore value: %w", err)
+		}
+		if rule.Expired(now) {
+			log.Warnf("ignore rule %s expired, the matches it waived are reported again", rule)
+		}
+		o.IgnoreRules = append(o.IgnoreRules, rule)
+	}
+	return nil
+}
+
+func (o *Xeol) parseTemplateOption() error {
+	if o.OutputTemplateFile == "" {
+		return nil
+	}
+
+	for _, out := range o.Outputs {
+		if format.Parse(strings.SplitN(out, "=", 2)[0]) == format.TemplateFormat {
+			return nil
+		}
+	}
+	return fmt.Errorf("--template requires the '%s' output format to be selected (e.g. '-o %s')", format.TemplateFormat, format.TemplateFormat)
+}
+
+func (o *Xeol) parseColumnsOption() error {
+	o.Columns = splitList(o.Columns)
+
+	_, err := csv.ParseColumns(o.Columns)
+	return err
+}
+
 func (o *Xeol) PostLoad() error {
-	return o.parseLookaheadOption()
+	if err := o.parseTemplateOption(); err != nil {
+		return err
+	}
+	if err := o.parseColumnsOption(); err != nil {
+		return err
+	}
+	if err := o.parseStatusOption(); err != nil {
+		return err
+	}
+	if err := o.parsePolicyOption(); err != nil {
+		return err
+	}
+	if err := o.parseIgnoreOption(); err != nil {
+		return err
+	}
+	if err := o.parseLookaheadOption(); err != nil {
+		return err
+	}
+	return o.parseWindowsOption()
 }
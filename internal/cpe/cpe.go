@@ -0,0 +1,30 @@
+package cpe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/syft/cpe"
+)
+
+// ShortCPEs returns the versionless forms of the CPE (e.g. cpe:/a:nodejs:node.js and cpe:2.3:a:nodejs:node.js)
+// that are used as keys of the cpes table. No forms are returned when the vendor or product is not known.
+func ShortCPEs(c cpe.CPE) []string {
+	a := c.Attributes
+	part := strings.ToLower(a.Part)
+	vendor := strings.ToLower(a.Vendor)
+	product := strings.ToLower(a.Product)
+
+	if part == "" || isAny(vendor) || isAny(product) {
+		return nil
+	}
+
+	return []string{
+		fmt.Sprintf("cpe:/%s:%s:%s", part, vendor, product),
+		fmt.Sprintf("cpe:2.3:%s:%s:%s", part, vendor, product),
+	}
+}
+
+func isAny(value string) bool {
+	return value == "" || value == cpe.Any || value == "-"
+}
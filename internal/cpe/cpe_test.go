@@ -0,0 +1,38 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/cpe"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShortCPEs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "application",
+			input:    "cpe:2.3:a:nodejs:node.js:14.17.0:*:*:*:*:*:*:*",
+			expected: []string{"cpe:/a:nodejs:node.js", "cpe:2.3:a:nodejs:node.js"},
+		},
+		{
+			name:     "mixed case",
+			input:    "cpe:2.3:a:Oracle:MySQL:8.0.30:*:*:*:*:*:*:*",
+			expected: []string{"cpe:/a:oracle:mysql", "cpe:2.3:a:oracle:mysql"},
+		},
+		{
+			name:     "any vendor",
+			input:    "cpe:2.3:a:*:mysql:8.0.30:*:*:*:*:*:*:*",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ShortCPEs(cpe.Must(tc.input, "")))
+		})
+	}
+}
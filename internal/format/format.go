@@ -5,9 +5,21 @@ import (
 )
 
 const (
-	UnknownFormat Format = "unknown"
-	JSONFormat    Format = "json"
-	TableFormat   Format = "table"
+	UnknownFormat  Format = "unknown"
+	JSONFormat     Format = "json"
+	TableFormat    Format = "table"
+	SarifFormat    Format = "sarif"
+	CycloneDXJSON  Format = "cyclonedx-json"
+	CycloneDXXML   Format = "cyclonedx-xml"
+	SPDXJSON       Format = "spdx-json"
+	OpenEoXFormat  Format = "openeox"
+	MarkdownFormat Format = "markdown"
+	TemplateFormat Format = "template"
+	JUnitFormat    Format = "junit"
+	HTMLFormat     Format = "html"
+	NDJSONFormat   Format = "ndjson"
+	CSVFormat      Format = "csv"
+	TSVFormat      Format = "tsv"
 )
 
 // Format is a dedicated type to represent a specific kind of presenter output format.
@@ -17,6 +29,11 @@ func (f Format) String() string {
 	return string(f)
 }
 
+// Streaming reports whether the format is able to write matches as soon as they are found.
+func (f Format) Streaming() bool {
+	return f == NDJSONFormat
+}
+
 // Parse returns the presenter.format specified by the given user input.
 func Parse(userInput string) Format {
 	switch strings.ToLower(userInput) {
@@ -26,6 +43,30 @@ func Parse(userInput string) Format {
 		return JSONFormat
 	case strings.ToLower(TableFormat.String()):
 		return TableFormat
+	case strings.ToLower(SarifFormat.String()):
+		return SarifFormat
+	case strings.ToLower(CycloneDXJSON.String()):
+		return CycloneDXJSON
+	case strings.ToLower(CycloneDXXML.String()):
+		return CycloneDXXML
+	case strings.ToLower(SPDXJSON.String()):
+		return SPDXJSON
+	case strings.ToLower(OpenEoXFormat.String()):
+		return OpenEoXFormat
+	case strings.ToLower(MarkdownFormat.String()):
+		return MarkdownFormat
+	case strings.ToLower(TemplateFormat.String()):
+		return TemplateFormat
+	case strings.ToLower(JUnitFormat.String()):
+		return JUnitFormat
+	case strings.ToLower(HTMLFormat.String()):
+		return HTMLFormat
+	case strings.ToLower(NDJSONFormat.String()):
+		return NDJSONFormat
+	case strings.ToLower(CSVFormat.String()):
+		return CSVFormat
+	case strings.ToLower(TSVFormat.String()):
+		return TSVFormat
 	default:
 		return UnknownFormat
 	}
@@ -35,4 +76,16 @@ func Parse(userInput string) Format {
 var AvailableFormats = []Format{
 	JSONFormat,
 	TableFormat,
+	SarifFormat,
+	CycloneDXJSON,
+	CycloneDXXML,
+	SPDXJSON,
+	OpenEoXFormat,
+	MarkdownFormat,
+	TemplateFormat,
+	JUnitFormat,
+	HTMLFormat,
+	NDJSONFormat,
+	CSVFormat,
+	TSVFormat,
 }
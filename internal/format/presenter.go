@@ -3,18 +3,59 @@ package format
 import (
 	"github.com/wagoodman/go-presenter"
 
+	"github.com/xeol-io/xeol/xeol/presenter/csv"
+	"github.com/xeol-io/xeol/xeol/presenter/cyclonedx"
+	"github.com/xeol-io/xeol/xeol/presenter/html"
 	"github.com/xeol-io/xeol/xeol/presenter/json"
+	"github.com/xeol-io/xeol/xeol/presenter/junit"
+	"github.com/xeol-io/xeol/xeol/presenter/markdown"
 	"github.com/xeol-io/xeol/xeol/presenter/models"
+	"github.com/xeol-io/xeol/xeol/presenter/ndjson"
+	"github.com/xeol-io/xeol/xeol/presenter/openeox"
+	"github.com/xeol-io/xeol/xeol/presenter/sarif"
+	"github.com/xeol-io/xeol/xeol/presenter/spdx"
 	"github.com/xeol-io/xeol/xeol/presenter/table"
+	"github.com/xeol-io/xeol/xeol/presenter/template"
 )
 
+// PresentationConfig holds the options that are specific to some of the presenters
+type PresentationConfig struct {
+	TemplateFilePath string
+	JUnitSkipPassing bool
+	Columns          []string
+}
+
 // GetPresenter retrieves a Presenter that matches a CLI option
-func GetPresenter(format Format, pb models.PresenterConfig) presenter.Presenter {
+func GetPresenter(format Format, c PresentationConfig, pb models.PresenterConfig) presenter.Presenter {
 	switch format {
 	case JSONFormat:
 		return json.NewPresenter(pb)
 	case TableFormat:
 		return table.NewPresenter(pb)
+	case SarifFormat:
+		return sarif.NewPresenter(pb)
+	case CycloneDXJSON:
+		return cyclonedx.NewJSONPresenter(pb)
+	case CycloneDXXML:
+		return cyclonedx.NewXMLPresenter(pb)
+	case SPDXJSON:
+		return spdx.NewPresenter(pb)
+	case OpenEoXFormat:
+		return openeox.NewPresenter(pb)
+	case MarkdownFormat:
+		return markdown.NewPresenter(pb)
+	case TemplateFormat:
+		return template.NewPresenter(pb, c.TemplateFilePath)
+	case JUnitFormat:
+		return junit.NewPresenter(pb, c.JUnitSkipPassing)
+	case HTMLFormat:
+		return html.NewPresenter(pb)
+	case NDJSONFormat:
+		return ndjson.NewPresenter(pb)
+	case CSVFormat:
+		return csv.NewCSVPresenter(pb, c.Columns)
+	case TSVFormat:
+		return csv.NewTSVPresenter(pb, c.Columns)
 	default:
 		return nil
 	}
@@ -13,14 +13,32 @@ import (
 
 	"github.com/xeol-io/xeol/internal/bus"
 	"github.com/xeol-io/xeol/internal/log"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/presenter/csv"
 	"github.com/xeol-io/xeol/xeol/presenter/models"
+	"github.com/xeol-io/xeol/xeol/presenter/ndjson"
 )
 
 type ScanResultWriter interface {
 	Write(result models.PresenterConfig) error
 }
 
-var _ ScanResultWriter = (*scanResultMultiWriter)(nil)
+// MatchWriter is a ScanResultWriter that is able to write matches as soon as they are found, for the
+// formats that support it (see Format.Streaming). The final Write then only completes the report.
+type MatchWriter interface {
+	ScanResultWriter
+	WriteMatch(m match.Match) error
+	// StreamsAllMatches reports whether every output writes matches as they are found, in which case
+	// the matches do not need to be collected for the final Write
+	StreamsAllMatches() bool
+}
+
+type matchStreamer interface {
+	WriteMatch(m match.Match) error
+	streamsMatches() bool
+}
+
+var _ MatchWriter = (*scanResultMultiWriter)(nil)
 
 var _ interface {
 	io.Closer
@@ -29,8 +47,8 @@ var _ interface {
 
 // MakeScanResultWriter creates a ScanResultWriter for output or returns an error. this will either return a valid writer
 // or an error but neither both and if there is no error, ScanResultWriter.Close() should be called
-func MakeScanResultWriter(outputs []string, defaultFile string) (ScanResultWriter, error) {
-	outputOptions, err := parseOutputFlags(outputs, defaultFile)
+func MakeScanResultWriter(outputs []string, defaultFile string, cfg PresentationConfig) (ScanResultWriter, error) {
+	outputOptions, err := parseOutputFlags(outputs, defaultFile, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -44,14 +62,14 @@ func MakeScanResultWriter(outputs []string, defaultFile string) (ScanResultWrite
 }
 
 // MakeScanResultWriterForFormat creates a ScanResultWriter for the given format or returns an error.
-func MakeScanResultWriterForFormat(f string, path string) (ScanResultWriter, error) {
+func MakeScanResultWriterForFormat(f string, path string, cfg PresentationConfig) (ScanResultWriter, error) {
 	format := Parse(f)
 
 	if format == UnknownFormat {
 		return nil, fmt.Errorf(`unsupported output format "%s", supported formats are: %+v`, f, AvailableFormats)
 	}
 
-	writer, err := newMultiWriter(newWriterDescription(format, path))
+	writer, err := newMultiWriter(newWriterDescription(format, path, cfg))
 	if err != nil {
 		return nil, err
 	}
@@ -60,29 +78,14 @@ func MakeScanResultWriterForFormat(f string, path string) (ScanResultWriter, err
 }
 
 // parseOutputFlags utility to parse command-line option strings and retain the existing behavior of default format and file
-func parseOutputFlags(outputs []string, defaultFile string) (out []scanResultWriterDescription, errs error) {
+func parseOutputFlags(outputs []string, defaultFile string, cfg PresentationConfig) (out []scanResultWriterDescription, errs error) {
 	// always should have one option -- we generally get the default of "table", but just make sure
 	if len(outputs) == 0 {
 		outputs = append(outputs, TableFormat.String())
 	}
 
-	for _, name := range outputs {
-		name = strings.TrimSpace(name)
-
-		// split to at most two parts for <format>=<file>
-		parts := strings.SplitN(name, "=", 2)
-
-		// the format name is the first part
-		name = parts[0]
-
-		// default to the --file or empty string if not specified
-		file := defaultFile
-
-		// If a file is specified as part of the output formatName, use that
-		if len(parts) > 1 {
-			file = parts[1]
-		}
-
+	for _, output := range outputs {
+		name, file := splitOutputFlag(output, defaultFile)
 		format := Parse(name)
 
 		if format == UnknownFormat {
@@ -90,18 +93,54 @@ func parseOutputFlags(outputs []string, defaultFile string) (out []scanResultWri
 			continue
 		}
 
-		out = append(out, newWriterDescription(format, file))
+		if format == TemplateFormat && cfg.TemplateFilePath == "" {
+			errs = multierror.Append(errs, fmt.Errorf(`must specify path to template file when using "%s" output format`, TemplateFormat))
+			continue
+		}
+
+		if format == CSVFormat || format == TSVFormat {
+			if _, err := csv.ParseColumns(cfg.Columns); err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+		}
+
+		out = append(out, newWriterDescription(format, file, cfg))
 	}
 	return out, errs
 }
 
+// splitOutputFlag splits a command-line option string of the form <format>[=<file>] into the format name and the
+// file, which defaults to the --file (or empty string) when not specified
+func splitOutputFlag(output, defaultFile string) (string, string) {
+	// split to at most two parts for <format>=<file>
+	parts := strings.SplitN(strings.TrimSpace(output), "=", 2)
+	if len(parts) > 1 {
+		return parts[0], parts[1]
+	}
+	return parts[0], defaultFile
+}
+
+// StreamsToStdout reports whether any of the outputs writes matches to stdout as soon as they are found. The UI
+// must be turned off for such outputs, since it draws on the same terminal.
+func StreamsToStdout(outputs []string, defaultFile string) bool {
+	for _, output := range outputs {
+		name, file := splitOutputFlag(output, defaultFile)
+		if file == "" && Parse(name).Streaming() {
+			return true
+		}
+	}
+	return false
+}
+
 // scanResultWriterDescription Format and path strings used to create ScanResultWriter
 type scanResultWriterDescription struct {
 	Format Format
 	Path   string
+	Cfg    PresentationConfig
 }
 
-func newWriterDescription(f Format, p string) scanResultWriterDescription {
+func newWriterDescription(f Format, p string, cfg PresentationConfig) scanResultWriterDescription {
 	expandedPath, err := homedir.Expand(p)
 	if err != nil {
 		log.Warnf("could not expand given writer output path=%q: %w", p, err)
@@ -111,6 +150,7 @@ func newWriterDescription(f Format, p string) scanResultWriterDescription {
 	return scanResultWriterDescription{
 		Format: f,
 		Path:   expandedPath,
+		Cfg:    cfg,
 	}
 }
 
@@ -128,10 +168,21 @@ func newMultiWriter(options ...scanResultWriterDescription) (_ *scanResultMultiW
 	out := &scanResultMultiWriter{}
 
 	for _, option := range options {
-		switch len(option.Path) {
-		case 0:
+		switch {
+		case len(option.Path) == 0 && option.Format.Streaming():
+			// streamed matches are written as they are found, so the whole stream (matches and trailer) bypasses the
+			// event bus and goes to stdout, with the UI turned off (see StreamsToStdout)
+			stdout := stdoutWriter{os.Stdout}
+			out.writers = append(out.writers, &scanResultStreamWriter{
+				format:   option.Format,
+				cfg:      option.Cfg,
+				out:      stdout,
+				streamer: newStreamer(option.Format, stdout),
+			})
+		case len(option.Path) == 0:
 			out.writers = append(out.writers, &scanResultPublisher{
 				format: option.Format,
+				cfg:    option.Cfg,
 			})
 		default:
 			// create any missing subdirectories
@@ -152,8 +203,10 @@ func newMultiWriter(options ...scanResultWriterDescription) (_ *scanResultMultiW
 				return nil, fmt.Errorf("unable to create report file: %w", err)
 			}
 			out.writers = append(out.writers, &scanResultStreamWriter{
-				format: option.Format,
-				out:    fileOut,
+				format:   option.Format,
+				cfg:      option.Cfg,
+				out:      fileOut,
+				streamer: newStreamer(option.Format, fileOut),
 			})
 		}
 	}
@@ -172,15 +225,66 @@ func (m *scanResultMultiWriter) Write(s models.PresenterConfig) (errs error) {
 	return errs
 }
 
+// WriteMatch writes the match to all writers that are able to stream matches
+func (m *scanResultMultiWriter) WriteMatch(match match.Match) (errs error) {
+	for _, w := range m.writers {
+		s, ok := w.(matchStreamer)
+		if !ok {
+			continue
+		}
+		if err := s.WriteMatch(match); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("unable to write match: %w", err))
+		}
+	}
+	return errs
+}
+
+// StreamsAllMatches reports whether all writers stream the matches as they are found
+func (m *scanResultMultiWriter) StreamsAllMatches() bool {
+	for _, w := range m.writers {
+		if s, ok := w.(matchStreamer); !ok || !s.streamsMatches() {
+			return false
+		}
+	}
+	return true
+}
+
+// newStreamer returns a streamer for formats that write matches as soon as they are found, nil otherwise
+func newStreamer(f Format, out io.Writer) *ndjson.Streamer {
+	if !f.Streaming() {
+		return nil
+	}
+	return ndjson.NewStreamer(out)
+}
+
 // scanResultStreamWriter implements ScanResultWriter for a given format and io.Writer, also providing a close function for cleanup
 type scanResultStreamWriter struct {
-	format Format
-	out    io.Writer
+	format   Format
+	cfg      PresentationConfig
+	out      io.Writer
+	streamer *ndjson.Streamer
+}
+
+// WriteMatch writes the match to the data stream, if the format supports streaming
+func (w *scanResultStreamWriter) WriteMatch(m match.Match) error {
+	if w.streamer == nil {
+		return nil
+	}
+	return w.streamer.WriteMatch(m)
+}
+
+func (w *scanResultStreamWriter) streamsMatches() bool {
+	return w.streamer != nil
 }
 
 // Write the provided result to the data stream
 func (w *scanResultStreamWriter) Write(s models.PresenterConfig) error {
-	pres := GetPresenter(w.format, s)
+	if w.streamer != nil && w.streamer.Written() > 0 {
+		// the matches have been written already, only the end of the stream is missing
+		return w.streamer.WriteTrailer(s)
+	}
+
+	pres := GetPresenter(w.format, w.cfg, s)
 	if err := pres.Present(w.out); err != nil {
 		return fmt.Errorf("unable to encode result: %w", err)
 	}
@@ -195,14 +299,20 @@ func (w *scanResultStreamWriter) Close() error {
 	return nil
 }
 
+// stdoutWriter writes to stdout, hiding its Close method so that stdout is never closed along with the writers
+type stdoutWriter struct {
+	io.Writer
+}
+
 // scanResultPublisher implements ScanResultWriter that publishes results to the event bus
 type scanResultPublisher struct {
 	format Format
+	cfg    PresentationConfig
 }
 
 // Write the provided result to the data stream
 func (w *scanResultPublisher) Write(s models.PresenterConfig) error {
-	pres := GetPresenter(w.format, s)
+	pres := GetPresenter(w.format, w.cfg, s)
 	buf := &bytes.Buffer{}
 	if err := pres.Present(buf); err != nil {
 		return fmt.Errorf("unable to encode result: %w", err)
@@ -7,13 +7,14 @@ import (
 	"github.com/xeol-io/xeol/xeol/pkg"
 )
 
-func ShortPurl(pkg pkg.Package) (string, error) {
-	if pkg.PURL == "" {
+// ShortPurl returns the PURL the package is searched by in the EOL database, without its version and qualifiers
+func ShortPurl(p pkg.Package) (string, error) {
+	if p.PURL == "" {
 		return "", fmt.Errorf("empty purl")
 	}
-	shortPurl := strings.Split(pkg.PURL, "@")
+	shortPurl := strings.Split(pkg.LookupPURL(p), "@")
 	if len(shortPurl) < 2 {
-		return "", fmt.Errorf("invalid purl format %s", pkg.PURL)
+		return "", fmt.Errorf("invalid purl format %s", p.PURL)
 	}
 	return shortPurl[0], nil
 }
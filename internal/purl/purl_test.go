@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 
+	syftPkg "github.com/anchore/syft/syft/pkg"
+
 	"github.com/xeol-io/xeol/xeol/pkg"
 )
 
@@ -28,6 +30,14 @@ func TestShortPurl(t *testing.T) {
 			},
 			expected: "pkg:deb/debian/curl",
 		},
+		{
+			name: "Binary runtime PURL mapped to its product",
+			input: pkg.Package{
+				Type: syftPkg.BinaryPkg,
+				PURL: "pkg:generic/java/jre@1.8.0_352-b08",
+			},
+			expected: "pkg:generic/java",
+		},
 		{
 			name: "Invalid PURL",
 			input: pkg.Package{
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPolicyTest(t *testing.T) {
+	policyFile := "./test-fixtures/policy/policy.yaml"
+	reportFile := "./test-fixtures/policy/report.json"
+
+	tests := []struct {
+		name       string
+		args       []string
+		assertions []traitAssertion
+	}{
+		{
+			name: "warn before the deny date",
+			args: []string{"policy", "test", policyFile, reportFile, "--as-of", "2024-03-01"},
+			assertions: []traitAssertion{
+				assertInOutput("python-deny"),
+				assertInOutput("2025-01-01"),
+				assertSucceedingReturnCode,
+			},
+		},
+		{
+			name: "deny after the deny date",
+			args: []string{"policy", "test", policyFile, reportFile, "--as-of", "2025-06-15"},
+			assertions: []traitAssertion{
+				assertInOutput("DENY"),
+				assertInOutput("prod-java"),
+				assertInOutput("java@20.0.1"),
+				assertFailingReturnCode,
+			},
+		},
+		{
+			name: "cycles without an EOL date are always evaluated",
+			args: []string{"policy", "test", policyFile, reportFile, "--as-of", "2020-01-01", "--lookahead", "1d"},
+			assertions: []traitAssertion{
+				assertInOutput("node@16.20.0"),
+				assertSucceedingReturnCode,
+			},
+		},
+		{
+			name: "bad as-of date",
+			args: []string{"policy", "test", policyFile, reportFile, "--as-of", "next year"},
+			assertions: []traitAssertion{
+				assertInOutput("bad --as-of value"),
+				assertFailingReturnCode,
+			},
+		},
+		{
+			name: "bad output format fails before reading the report",
+			args: []string{"policy", "test", policyFile, "./test-fixtures/policy/missing.json", "-o", "yaml"},
+			assertions: []traitAssertion{
+				assertInOutput("unsupported output format: yaml"),
+				assertFailingReturnCode,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd, stdout, stderr := runXeol(t, nil, test.args...)
+			for _, traitAssertionFn := range test.assertions {
+				traitAssertionFn(t, stdout, stderr, cmd.ProcessState.ExitCode())
+			}
+			if t.Failed() {
+				t.Log("STDOUT:\n", stdout)
+				t.Log("STDERR:\n", stderr)
+				t.Log("COMMAND:", strings.Join(cmd.Args, " "))
+			}
+		})
+	}
+}
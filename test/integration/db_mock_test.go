@@ -15,6 +15,16 @@ func (s *mockStore) GetCyclesByPurl(purl string) ([]xeolDB.Cycle, error) {
 	return s.backend[purl], nil
 }
 
+func (s *mockStore) GetCyclesByPurls(purls []string) (map[string][]xeolDB.Cycle, error) {
+	cycles := make(map[string][]xeolDB.Cycle)
+	for _, purl := range purls {
+		if c, ok := s.backend[purl]; ok {
+			cycles[purl] = c
+		}
+	}
+	return cycles, nil
+}
+
 func (s *mockStore) GetCyclesByCpe(cpe string) ([]xeolDB.Cycle, error) {
 	return s.backend[cpe], nil
 }
@@ -23,6 +33,10 @@ func (s *mockStore) GetVulnCountByPurlAndVersion(purl string, version string) (i
 	return 0, nil
 }
 
+func (s *mockStore) GetVulnCountsByPurls(_ []string) (map[string]map[string]int, error) {
+	return nil, nil
+}
+
 func (s *mockStore) GetAllProducts() (*[]xeolDB.Product, error) {
 	return nil, nil
 }
@@ -318,7 +318,7 @@ func TestMatchByImage(t *testing.T) {
 			require.NoError(t, err)
 			require.NotNil(t, s)
 
-			matchers := matcher.NewDefaultMatchers(matcher.Config{})
+			matchers := matcher.NewDefaultMatchers(matcher.DefaultConfig())
 
 			ep, err := db.NewEolProvider(theStore)
 			require.NoError(t, err)
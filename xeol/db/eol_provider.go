@@ -2,9 +2,13 @@ package db
 
 import (
 	"errors"
+	"slices"
+	"sync"
 
 	"github.com/anchore/syft/syft/linux"
+	"github.com/scylladb/go-set/strset"
 
+	"github.com/xeol-io/xeol/internal/cpe"
 	"github.com/xeol-io/xeol/internal/purl"
 	xeolDB "github.com/xeol-io/xeol/xeol/db/v1"
 	"github.com/xeol-io/xeol/xeol/distro"
@@ -13,19 +17,106 @@ import (
 )
 
 var _ eol.Provider = (*EolProvider)(nil)
+var _ eol.Prefetcher = (*EolProvider)(nil)
 
+// EolProvider provides the cycles of packages and distros from the EOL database. The results of every lookup are
+// memoized for the lifetime of the provider (a single scan), since many packages share the same short PURL or CPE.
 type EolProvider struct {
 	reader xeolDB.EolStoreReader
+
+	lock         sync.RWMutex
+	cyclesByPurl map[string][]eol.Cycle
+	cyclesByCpe  map[string][]eol.Cycle
+	vulnCounts   map[vulnKey]int
+	// vulnPurls are the PURLs whose vulnerability counts have all been loaded
+	vulnPurls *strset.Set
+}
+
+type vulnKey struct {
+	purl    string
+	version string
 }
 
 func NewEolProvider(reader xeolDB.EolStoreReader) (*EolProvider, error) {
 	return &EolProvider{
-		reader: reader,
+		reader:       reader,
+		cyclesByPurl: make(map[string][]eol.Cycle),
+		cyclesByCpe:  make(map[string][]eol.Cycle),
+		vulnCounts:   make(map[vulnKey]int),
+		vulnPurls:    strset.New(),
 	}, nil
 }
 
+// Prefetch loads the cycles and vulnerability counts of the given packages (and their upstream packages) in bulk,
+// so that matching them does not query the database once per package.
+func (pr *EolProvider) Prefetch(packages []pkg.Package) error {
+	purls := strset.New()
+	for _, p := range packages {
+		for _, candidate := range append([]pkg.Package{p}, pkg.UpstreamPackages(p)...) {
+			shortPurl, err := purl.ShortPurl(candidate)
+			if err != nil {
+				continue
+			}
+			purls.Add(shortPurl)
+		}
+	}
+
+	pr.lock.RLock()
+	var missing []string
+	for _, shortPurl := range purls.List() {
+		if _, ok := pr.cyclesByPurl[shortPurl]; !ok {
+			missing = append(missing, shortPurl)
+		}
+	}
+	pr.lock.RUnlock()
+	if len(missing) == 0 {
+		return nil
+	}
+	slices.Sort(missing)
+
+	allCycles, err := pr.reader.GetCyclesByPurls(missing)
+	if err != nil {
+		return err
+	}
+
+	cyclesByPurl := make(map[string][]eol.Cycle, len(missing))
+	var withCycles []string
+	for _, shortPurl := range missing {
+		cycles, err := newCycles(allCycles[shortPurl])
+		if err != nil {
+			return err
+		}
+		cyclesByPurl[shortPurl] = cycles
+		if len(cycles) > 0 {
+			withCycles = append(withCycles, shortPurl)
+		}
+	}
+
+	// vulnerability counts are only looked up for packages that matched a cycle
+	var vulnCounts map[string]map[string]int
+	if len(withCycles) > 0 {
+		vulnCounts, err = pr.reader.GetVulnCountsByPurls(withCycles)
+		if err != nil {
+			return err
+		}
+	}
+
+	pr.lock.Lock()
+	defer pr.lock.Unlock()
+	for shortPurl, cycles := range cyclesByPurl {
+		pr.cyclesByPurl[shortPurl] = cycles
+	}
+	for _, shortPurl := range withCycles {
+		for version, count := range vulnCounts[shortPurl] {
+			pr.vulnCounts[vulnKey{purl: shortPurl, version: version}] = count
+		}
+		pr.vulnPurls.Add(shortPurl)
+	}
+	return nil
+}
+
 func (pr *EolProvider) GetByDistroCpe(r *linux.Release) (string, []eol.Cycle, string, error) {
-	cycles := make([]eol.Cycle, 0)
+	var cycles []eol.Cycle
 	if r == nil {
 		return "", []eol.Cycle{}, "", errors.New("empty distro release")
 	}
@@ -44,44 +135,65 @@ func (pr *EolProvider) GetByDistroCpe(r *linux.Release) (string, []eol.Cycle, st
 		return "", []eol.Cycle{}, "", errors.New("invalid distro CPEName")
 	}
 
-	allCycles, err := pr.reader.GetCyclesByCpe(shortCPE)
+	cycles, err = pr.getByCpe(shortCPE)
 	if err != nil {
 		return "", []eol.Cycle{}, "", err
 	}
 
-	for _, cycle := range allCycles {
-		cycleObj, err := eol.NewCycle(cycle)
-		if err != nil {
-			return "", []eol.Cycle{}, "", err
-		}
-		cycles = append(cycles, *cycleObj)
-	}
-
 	return version, cycles, shortCPE, nil
 }
 
 func (pr *EolProvider) GetByPackagePurl(p pkg.Package) ([]eol.Cycle, error) {
-	cycles := make([]eol.Cycle, 0)
-
 	shortPurl, err := purl.ShortPurl(p)
 	if err != nil {
 		return []eol.Cycle{}, err
 	}
 
+	pr.lock.RLock()
+	cycles, ok := pr.cyclesByPurl[shortPurl]
+	pr.lock.RUnlock()
+	if ok {
+		return slices.Clone(cycles), nil
+	}
+
 	allCycles, err := pr.reader.GetCyclesByPurl(shortPurl)
 	if err != nil {
 		return []eol.Cycle{}, err
 	}
 
-	for _, cycle := range allCycles {
-		cycleObj, err := eol.NewCycle(cycle)
-		if err != nil {
-			return []eol.Cycle{}, err
+	cycles, err = newCycles(allCycles)
+	if err != nil {
+		return []eol.Cycle{}, err
+	}
+
+	pr.lock.Lock()
+	pr.cyclesByPurl[shortPurl] = cycles
+	pr.lock.Unlock()
+
+	return slices.Clone(cycles), nil
+}
+
+func (pr *EolProvider) GetByPackageCpe(p pkg.Package) ([]eol.Cycle, string, error) {
+	seen := strset.New()
+	for _, c := range p.CPEs {
+		for _, shortCPE := range cpe.ShortCPEs(c) {
+			if seen.Has(shortCPE) {
+				continue
+			}
+			seen.Add(shortCPE)
+
+			cycles, err := pr.getByCpe(shortCPE)
+			if err != nil {
+				return []eol.Cycle{}, "", err
+			}
+			if len(cycles) == 0 {
+				continue
+			}
+			return cycles, shortCPE, nil
 		}
-		cycles = append(cycles, *cycleObj)
 	}
 
-	return cycles, nil
+	return []eol.Cycle{}, "", nil
 }
 
 func (pr *EolProvider) GetVulnCount(p pkg.Package) (int, error) {
@@ -90,10 +202,61 @@ func (pr *EolProvider) GetVulnCount(p pkg.Package) (int, error) {
 		return 0, err
 	}
 
-	vulnCount, err := pr.reader.GetVulnCountByPurlAndVersion(shortPurl, p.Version)
+	key := vulnKey{purl: shortPurl, version: p.Version}
+	pr.lock.RLock()
+	vulnCount, ok := pr.vulnCounts[key]
+	loaded := pr.vulnPurls.Has(shortPurl)
+	pr.lock.RUnlock()
+	if ok || loaded {
+		return vulnCount, nil
+	}
+
+	vulnCount, err = pr.reader.GetVulnCountByPurlAndVersion(shortPurl, p.Version)
 	if err != nil {
 		return 0, err
 	}
 
+	pr.lock.Lock()
+	pr.vulnCounts[key] = vulnCount
+	pr.lock.Unlock()
+
 	return vulnCount, nil
 }
+
+// getByCpe returns the (memoized) cycles of the given short CPE
+func (pr *EolProvider) getByCpe(shortCPE string) ([]eol.Cycle, error) {
+	pr.lock.RLock()
+	cycles, ok := pr.cyclesByCpe[shortCPE]
+	pr.lock.RUnlock()
+	if ok {
+		return slices.Clone(cycles), nil
+	}
+
+	allCycles, err := pr.reader.GetCyclesByCpe(shortCPE)
+	if err != nil {
+		return nil, err
+	}
+
+	cycles, err = newCycles(allCycles)
+	if err != nil {
+		return nil, err
+	}
+
+	pr.lock.Lock()
+	pr.cyclesByCpe[shortCPE] = cycles
+	pr.lock.Unlock()
+
+	return slices.Clone(cycles), nil
+}
+
+func newCycles(dbCycles []xeolDB.Cycle) ([]eol.Cycle, error) {
+	cycles := make([]eol.Cycle, 0, len(dbCycles))
+	for _, cycle := range dbCycles {
+		cycleObj, err := eol.NewCycle(cycle)
+		if err != nil {
+			return nil, err
+		}
+		cycles = append(cycles, *cycleObj)
+	}
+	return cycles, nil
+}
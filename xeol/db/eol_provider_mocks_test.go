@@ -4,11 +4,14 @@ import xeolDB "github.com/xeol-io/xeol/xeol/db/v1"
 
 type mockStore struct {
 	data map[string][]xeolDB.Cycle
+	// queries counts the calls of each reader method
+	queries map[string]int
 }
 
 func newMockStore() *mockStore {
 	d := mockStore{
-		data: make(map[string][]xeolDB.Cycle),
+		data:    make(map[string][]xeolDB.Cycle),
+		queries: make(map[string]int),
 	}
 	d.stub()
 	return &d
@@ -28,13 +31,40 @@ func (d *mockStore) stub() {
 }
 
 func (s *mockStore) GetCyclesByPurl(purl string) ([]xeolDB.Cycle, error) {
+	s.queries["GetCyclesByPurl"]++
 	return s.data[purl], nil
 }
 
+func (s *mockStore) GetCyclesByPurls(purls []string) (map[string][]xeolDB.Cycle, error) {
+	s.queries["GetCyclesByPurls"]++
+	cycles := make(map[string][]xeolDB.Cycle)
+	for _, purl := range purls {
+		if c, ok := s.data[purl]; ok {
+			cycles[purl] = c
+		}
+	}
+	return cycles, nil
+}
+
 func (s *mockStore) GetCyclesByCpe(cpe string) ([]xeolDB.Cycle, error) {
+	s.queries["GetCyclesByCpe"]++
 	return s.data[cpe], nil
 }
 
 func (s *mockStore) GetAllProducts() (*[]xeolDB.Product, error) {
 	return nil, nil
 }
+
+func (s *mockStore) GetVulnCountByPurlAndVersion(_ string, _ string) (int, error) {
+	s.queries["GetVulnCountByPurlAndVersion"]++
+	return 3, nil
+}
+
+func (s *mockStore) GetVulnCountsByPurls(purls []string) (map[string]map[string]int, error) {
+	s.queries["GetVulnCountsByPurls"]++
+	counts := make(map[string]map[string]int)
+	for _, purl := range purls {
+		counts[purl] = map[string]int{"3.2.0": 5}
+	}
+	return counts, nil
+}
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/pkg"
+)
+
+func TestEolProviderMemoizesLookups(t *testing.T) {
+	store := newMockStore()
+	provider, err := NewEolProvider(store)
+	require.NoError(t, err)
+
+	for _, version := range []string{"3.2.0", "3.2.1", "3.2.0"} {
+		p := pkg.Package{Name: "mongodb-org-server", Version: version, PURL: "pkg:deb/debian/mongodb-org-server@" + version}
+
+		cycles, err := provider.GetByPackagePurl(p)
+		require.NoError(t, err)
+		assert.Len(t, cycles, 1)
+
+		count, err := provider.GetVulnCount(p)
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+	}
+
+	assert.Equal(t, map[string]int{
+		"GetCyclesByPurl":              1,
+		"GetVulnCountByPurlAndVersion": 2,
+	}, store.queries)
+}
+
+func TestEolProviderPrefetch(t *testing.T) {
+	store := newMockStore()
+	provider, err := NewEolProvider(store)
+	require.NoError(t, err)
+
+	var packages []pkg.Package
+	for _, version := range []string{"3.2.0", "3.2.1", "3.4.0"} {
+		packages = append(packages, pkg.Package{Name: "mongodb-org-server", Version: version, PURL: "pkg:deb/debian/mongodb-org-server@" + version})
+	}
+	packages = append(packages,
+		pkg.Package{Name: "unknown", Version: "1.0.0", PURL: "pkg:npm/unknown@1.0.0"},
+		pkg.Package{Name: "no-purl", Version: "1.0.0"},
+	)
+
+	require.NoError(t, provider.Prefetch(packages))
+
+	var counts []int
+	for _, p := range packages[:3] {
+		cycles, err := provider.GetByPackagePurl(p)
+		require.NoError(t, err)
+		assert.Len(t, cycles, 1)
+
+		count, err := provider.GetVulnCount(p)
+		require.NoError(t, err)
+		counts = append(counts, count)
+	}
+	cycles, err := provider.GetByPackagePurl(packages[3])
+	require.NoError(t, err)
+	assert.Empty(t, cycles)
+
+	// versions without a vulnerability count of a prefetched PURL have no vulnerabilities
+	assert.Equal(t, []int{5, 0, 0}, counts)
+	assert.Equal(t, map[string]int{
+		"GetCyclesByPurls":     1,
+		"GetVulnCountsByPurls": 1,
+	}, store.queries)
+
+	// prefetching the same packages again does not query the store
+	require.NoError(t, provider.Prefetch(packages))
+	assert.Equal(t, 1, store.queries["GetCyclesByPurls"])
+}
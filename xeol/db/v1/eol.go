@@ -35,8 +35,13 @@ type EolStore interface {
 
 type EolStoreReader interface {
 	GetCyclesByPurl(purl string) ([]Cycle, error)
+	// GetCyclesByPurls returns the cycles of each of the given PURLs, PURLs without cycles are omitted
+	GetCyclesByPurls(purls []string) (map[string][]Cycle, error)
 	GetCyclesByCpe(cpe string) ([]Cycle, error)
 	GetVulnCountByPurlAndVersion(purl string, version string) (int, error)
+	// GetVulnCountsByPurls returns the vulnerability count of each known version of the given PURLs, keyed by PURL
+	// and version
+	GetVulnCountsByPurls(purls []string) (map[string]map[string]int, error)
 	GetAllProducts() (*[]Product, error)
 }
 
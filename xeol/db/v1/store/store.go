@@ -11,6 +11,9 @@ import (
 	"github.com/xeol-io/xeol/xeol/db/v1/store/model"
 )
 
+// queryBatchSize is the maximum number of values bound to a single IN clause
+const queryBatchSize = 500
+
 // store holds an instance of the database connection
 type store struct {
 	db *gorm.DB
@@ -144,6 +147,32 @@ func (s *store) GetCyclesByPurl(purl string) ([]v1.Cycle, error) {
 	return cycles, nil
 }
 
+func (s *store) GetCyclesByPurls(purls []string) (map[string][]v1.Cycle, error) {
+	cycles := make(map[string][]v1.Cycle)
+	for _, batch := range batches(purls) {
+		var models []struct {
+			model.CycleModel
+			Purl string `gorm:"column:purl"`
+		}
+		if result := s.db.Table("cycles").
+			Select("cycles.*, products.name as product_name, products.permalink as product_permalink, purls.purl as purl").
+			Joins("JOIN products ON cycles.product_id = products.id").
+			Joins("JOIN purls ON products.id = purls.product_id").
+			Where("purls.purl IN ?", batch).Find(&models); result.Error != nil {
+			return nil, result.Error
+		}
+
+		for _, m := range models {
+			c, err := m.Inflate()
+			if err != nil {
+				return nil, err
+			}
+			cycles[m.Purl] = append(cycles[m.Purl], c)
+		}
+	}
+	return cycles, nil
+}
+
 func (s *store) GetVulnCountByPurlAndVersion(purl string, version string) (int, error) {
 	var vulnCount int
 	if result := s.db.Table("vulns").
@@ -154,3 +183,41 @@ func (s *store) GetVulnCountByPurlAndVersion(purl string, version string) (int,
 	}
 	return vulnCount, nil
 }
+
+func (s *store) GetVulnCountsByPurls(purls []string) (map[string]map[string]int, error) {
+	counts := make(map[string]map[string]int)
+	for _, batch := range batches(purls) {
+		var rows []struct {
+			Purl       string `gorm:"column:purl"`
+			Version    string `gorm:"column:version"`
+			IssueCount int    `gorm:"column:issue_count"`
+		}
+		if result := s.db.Table("vulns").
+			Select("purls.purl, vulns.version, vulns.issue_count").
+			Joins("JOIN purls ON vulns.product_id = purls.product_id").
+			Where("purls.purl IN ?", batch).Find(&rows); result.Error != nil {
+			return nil, result.Error
+		}
+
+		for _, r := range rows {
+			if counts[r.Purl] == nil {
+				counts[r.Purl] = make(map[string]int)
+			}
+			counts[r.Purl][r.Version] = r.IssueCount
+		}
+	}
+	return counts, nil
+}
+
+// batches splits the values into chunks of at most queryBatchSize values
+func batches(values []string) [][]string {
+	var chunks [][]string
+	for len(values) > queryBatchSize {
+		chunks = append(chunks, values[:queryBatchSize])
+		values = values[queryBatchSize:]
+	}
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+	return chunks
+}
@@ -48,3 +48,90 @@ func TestStore_GetID_SetID(t *testing.T) {
 	assertIDReader(t, s, expected)
 
 }
+
+func newTestEolStore(t *testing.T) *store {
+	t.Helper()
+	dbTempFile, err := os.CreateTemp("", "xeol-db-test-store")
+	if err != nil {
+		t.Fatalf("could not create temp file: %+v", err)
+	}
+	t.Cleanup(func() { os.Remove(dbTempFile.Name()) })
+
+	s, err := New(dbTempFile.Name(), true)
+	if err != nil {
+		t.Fatalf("could not create store: %+v", err)
+	}
+
+	statements := []string{
+		"CREATE TABLE products (id INTEGER PRIMARY KEY, name TEXT, permalink TEXT)",
+		"CREATE TABLE cycles (id INTEGER PRIMARY KEY, product_id INTEGER, release_cycle TEXT, eol DATETIME, eol_bool BOOLEAN, lts TEXT, latest_release TEXT, latest_release_date DATETIME, release_date DATETIME)",
+		"CREATE TABLE purls (id INTEGER PRIMARY KEY, product_id INTEGER, purl TEXT)",
+		"CREATE TABLE vulns (id INTEGER PRIMARY KEY, product_id INTEGER, version TEXT, issue_count INTEGER)",
+		"INSERT INTO products VALUES (1, 'Node.js', '/nodejs'), (2, 'Python', '/python')",
+		"INSERT INTO cycles VALUES (1, 1, '14', '2023-04-30 00:00:00', false, '', '14.21.3', '2023-02-16 00:00:00', '2020-04-21 00:00:00')",
+		"INSERT INTO cycles VALUES (2, 1, '16', '2023-09-11 00:00:00', false, '', '16.20.2', '2023-08-08 00:00:00', '2021-04-20 00:00:00')",
+		"INSERT INTO cycles VALUES (3, 2, '3.6', '2021-12-23 00:00:00', false, '', '3.6.15', '2021-09-04 00:00:00', '2016-12-23 00:00:00')",
+		"INSERT INTO purls VALUES (1, 1, 'pkg:generic/node'), (2, 1, 'pkg:npm/node'), (3, 2, 'pkg:generic/python')",
+		"INSERT INTO vulns VALUES (1, 1, '14.0.0', 12), (2, 1, '16.0.0', 3), (3, 2, '3.6.0', 7)",
+	}
+	for _, statement := range statements {
+		if err := s.(*store).db.Exec(statement).Error; err != nil {
+			t.Fatalf("could not set up store: %+v", err)
+		}
+	}
+	return s.(*store)
+}
+
+func TestStore_GetCyclesByPurls(t *testing.T) {
+	s := newTestEolStore(t)
+
+	actual, err := s.GetCyclesByPurls([]string{"pkg:npm/node", "pkg:generic/python", "pkg:generic/unknown"})
+	if err != nil {
+		t.Fatalf("failed to get cycles: %+v", err)
+	}
+
+	// the batched results must be the same as querying each PURL on its own
+	expected := make(map[string][]v1.Cycle)
+	for _, purl := range []string{"pkg:npm/node", "pkg:generic/python"} {
+		cycles, err := s.GetCyclesByPurl(purl)
+		if err != nil {
+			t.Fatalf("failed to get cycles: %+v", err)
+		}
+		expected[purl] = cycles
+	}
+
+	if len(expected["pkg:npm/node"]) != 2 || len(expected["pkg:generic/python"]) != 1 {
+		t.Fatalf("unexpected fixture cycles: %+v", expected)
+	}
+	for _, d := range deep.Equal(expected, actual) {
+		t.Errorf("Diff: %+v", d)
+	}
+}
+
+func TestStore_GetVulnCountsByPurls(t *testing.T) {
+	s := newTestEolStore(t)
+
+	actual, err := s.GetVulnCountsByPurls([]string{"pkg:npm/node", "pkg:generic/python", "pkg:generic/unknown"})
+	if err != nil {
+		t.Fatalf("failed to get vuln counts: %+v", err)
+	}
+
+	expected := map[string]map[string]int{
+		"pkg:npm/node":       {"14.0.0": 12, "16.0.0": 3},
+		"pkg:generic/python": {"3.6.0": 7},
+	}
+	for _, d := range deep.Equal(expected, actual) {
+		t.Errorf("Diff: %+v", d)
+	}
+}
+
+func TestBatches(t *testing.T) {
+	values := make([]string, 2*queryBatchSize+1)
+	chunks := batches(values)
+	if len(chunks) != 3 || len(chunks[0]) != queryBatchSize || len(chunks[2]) != 1 {
+		t.Errorf("unexpected batches: %d", len(chunks))
+	}
+	if len(batches(nil)) != 0 {
+		t.Errorf("expected no batches for no values")
+	}
+}
@@ -1,9 +1,18 @@
 package eol
 
 import (
+	"strconv"
+	"time"
+
 	xeolDB "github.com/xeol-io/xeol/xeol/db/v1"
 )
 
+// DateLayout is the layout of all dates (EOL, release, latest release) found on a cycle
+const DateLayout = "2006-01-02"
+
+// zeroDate is the date the store reports for cycles without an EOL date
+const zeroDate = "0001-01-01"
+
 type Cycle struct {
 	ProductName       string
 	ProductPermalink  string
@@ -29,3 +38,32 @@ func NewCycle(cycle xeolDB.Cycle) (*Cycle, error) {
 		ReleaseDate:       cycle.ReleaseDate,
 	}, nil
 }
+
+// HasEolDate reports whether the cycle has an EOL date. Cycles without one have an empty date, or the zero
+// date reported by the store.
+func (c Cycle) HasEolDate() bool {
+	return c.Eol != "" && c.Eol != zeroDate
+}
+
+// IsLTS reports whether the cycle is a long-term support cycle at the given time. The LTS value is a boolean, or
+// the date the cycle becomes LTS on, which counts once it is not in the future. Any other non-empty value
+// counts as LTS.
+func (c Cycle) IsLTS(now time.Time) bool {
+	if lts, err := strconv.ParseBool(c.LTS); err == nil {
+		return lts
+	}
+	if date, err := time.Parse(DateLayout, c.LTS); err == nil {
+		return !date.After(now)
+	}
+	return c.LTS != ""
+}
+
+// DaysEol returns the number of days between the cycle EOL date and the given time. The value is negative
+// when the EOL date is still in the future.
+func (c Cycle) DaysEol(now time.Time) (int, error) {
+	cycleEolDate, err := time.Parse(DateLayout, c.Eol)
+	if err != nil {
+		return 0, err
+	}
+	return int(now.Sub(cycleEolDate).Hours() / 24), nil
+}
@@ -8,6 +8,7 @@ import (
 
 type Provider interface {
 	ProviderByPackagePurl
+	ProviderByPackageCpe
 	ProviderByDistroCpe
 }
 
@@ -16,6 +17,16 @@ type ProviderByPackagePurl interface {
 	GetVulnCount(p pkg.Package) (int, error)
 }
 
+type ProviderByPackageCpe interface {
+	// GetByPackageCpe returns the cycles of the first package CPE known to the provider, along with that (short) CPE
+	GetByPackageCpe(p pkg.Package) ([]Cycle, string, error)
+}
+
 type ProviderByDistroCpe interface {
 	GetByDistroCpe(distro *linux.Release) (string, []Cycle, string, error)
 }
+
+// Prefetcher is implemented by providers that can load the data of many packages at once, ahead of matching them
+type Prefetcher interface {
+	Prefetch(packages []pkg.Package) error
+}
@@ -17,14 +17,68 @@ type EolMatcher struct {
 	Matchers       []matcher.Matcher
 	FailOnEolFound bool
 	EolMatchDate   time.Time
+	Windows        []match.Window // windows for StatusEolWithinWindow, a single window up to EolMatchDate when empty
+	Statuses       []match.Status // statuses reported, match.DefaultStatuses when empty
+	Workers        int            // number of packages matched concurrently, the number of CPUs when < 1
+	IgnoreRules    []match.IgnoreRule
 	LinuxRelease   *linux.Release
 }
 
-func (e *EolMatcher) FindEol(packages []pkg.Package) (match.Matches, error) {
-	matches := matcher.FindMatches(e.Store, e.LinuxRelease, e.Matchers, packages, e.FailOnEolFound, e.EolMatchDate)
+func (e *EolMatcher) statusConfig() match.StatusConfig {
+	windows := e.Windows
+	if len(windows) == 0 && !e.EolMatchDate.IsZero() {
+		windows = []match.Window{{Name: "lookahead", End: e.EolMatchDate}}
+	}
+	return match.StatusConfig{
+		Windows:  windows,
+		Statuses: e.Statuses,
+	}
+}
+
+// FindEol returns the reported matches of the packages, along with the matches waived by the ignore rules.
+// Ignored matches are not considered for FailOnEolFound.
+func (e *EolMatcher) FindEol(packages []pkg.Package) (match.Matches, []match.IgnoredMatch, error) {
+	matches := matcher.FindMatches(e.Store, e.LinuxRelease, e.Matchers, packages, e.FailOnEolFound, e.statusConfig(), e.Workers)
+	matches, ignored := match.ApplyIgnoreRules(matches, e.IgnoreRules, time.Now())
+	var err error
+	if e.FailOnEolFound && hasEol(matches.Sorted()) {
+		err = xeolerr.ErrEolFound
+	}
+	return matches, ignored, err
+}
+
+// StreamEol calls onMatch for every reported match as soon as it is found, without collecting the matches.
+// It returns the number of matches passed on, along with the matches waived by the ignore rules, which are not
+// passed on.
+func (e *EolMatcher) StreamEol(packages []pkg.Package, onMatch func(match.Match)) (int, []match.IgnoredMatch, error) {
+	var eolFound bool
+	var ignored []match.IgnoredMatch
+	now := time.Now()
+	count := 0
+	matcher.StreamMatches(e.Store, e.LinuxRelease, e.Matchers, packages, e.FailOnEolFound, e.statusConfig(), e.Workers, func(m match.Match) {
+		if rules := match.IgnoredBy(m, e.IgnoreRules, now); len(rules) > 0 {
+			ignored = append(ignored, match.IgnoredMatch{Match: m, AppliedIgnoreRules: rules})
+			return
+		}
+		if m.Status.IsEol() {
+			eolFound = true
+		}
+		count++
+		onMatch(m)
+	})
 	var err error
-	if e.FailOnEolFound && matches.Count() > 0 {
+	if e.FailOnEolFound && eolFound {
 		err = xeolerr.ErrEolFound
 	}
-	return matches, err
+	return count, ignored, err
+}
+
+// hasEol reports whether any of the matches is EOL, either already or within a window
+func hasEol(matches []match.Match) bool {
+	for _, m := range matches {
+		if m.Status.IsEol() {
+			return true
+		}
+	}
+	return false
 }
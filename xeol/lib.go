@@ -15,9 +15,11 @@ import (
 )
 
 func FindEol(store store.Store, d *linux.Release, matchers []matcher.Matcher, packages []pkg.Package, failOnEolFound bool, eolMatchDate time.Time) (match.Matches, error) {
-	matches := matcher.FindMatches(store, d, matchers, packages, failOnEolFound, eolMatchDate)
+	matches := matcher.FindMatches(store, d, matchers, packages, failOnEolFound, match.StatusConfig{
+		Windows: []match.Window{{Name: "lookahead", End: eolMatchDate}},
+	}, 0)
 	var err error
-	if failOnEolFound && matches.Count() > 0 {
+	if failOnEolFound && hasEol(matches.Sorted()) {
 		err = xeolerr.ErrEolFound
 	}
 	return matches, err
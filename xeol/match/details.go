@@ -0,0 +1,11 @@
+package match
+
+// Detail explains how a match was found: which matcher ran, what was searched for and what was found.
+type Detail struct {
+	Type       Method      // The kind of lookup that produced the match.
+	Matcher    MatcherType // The matcher that produced the match.
+	SearchedBy interface{} // The parameters used to search the EOL database.
+	Found      interface{} // The data found in the EOL database that produced the match.
+}
+
+type Details []Detail
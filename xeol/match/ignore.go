@@ -0,0 +1,157 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v2"
+
+	"github.com/xeol-io/xeol/internal/log"
+	"github.com/xeol-io/xeol/xeol/eol"
+)
+
+// IgnoreRule waives the matches it applies to: they are not reported as findings, nor considered when gating
+// (--fail-on-eol-found, policies), but are listed separately along with the reason. A match must satisfy every
+// criterion given in the rule. A rule with an Until date applies through that date and stops applying the day after,
+// so the matches it waived resurface automatically.
+type IgnoreRule struct {
+	Product  string `yaml:"product" json:"product,omitempty" mapstructure:"product"`    // the product name, e.g. "Python"
+	Cycle    string `yaml:"cycle" json:"cycle,omitempty" mapstructure:"cycle"`          // the release cycle, e.g. "3.7"
+	Purl     string `yaml:"purl" json:"purl,omitempty" mapstructure:"purl"`             // a glob matched against the package PURL, e.g. "pkg:npm/**"
+	Type     string `yaml:"type" json:"type,omitempty" mapstructure:"type"`             // the package type, e.g. "npm"
+	Location string `yaml:"location" json:"location,omitempty" mapstructure:"location"` // a glob matched against the package locations, e.g. "/opt/legacy/**"
+	Reason   string `yaml:"reason" json:"reason" mapstructure:"reason"`                 // the justification for the waiver (required)
+	Until    string `yaml:"until" json:"until,omitempty" mapstructure:"until"`          // the last date (YYYY-MM-DD) the waiver applies on
+}
+
+// IgnoredMatch is a match that was waived by one or more ignore rules
+type IgnoredMatch struct {
+	Match
+	AppliedIgnoreRules []IgnoreRule
+}
+
+// Validate returns an error for rules without a reason, without any criteria or with an invalid until date
+func (r IgnoreRule) Validate() error {
+	if strings.TrimSpace(r.Reason) == "" {
+		return fmt.Errorf("ignore rule %s has no reason", r)
+	}
+	if r.Product == "" && r.Cycle == "" && r.Purl == "" && r.Type == "" && r.Location == "" {
+		return fmt.Errorf("ignore rule with reason %q has no product, cycle, purl, type or location to match", r.Reason)
+	}
+	if r.Until != "" {
+		if _, err := time.Parse(eol.DateLayout, r.Until); err != nil {
+			return fmt.Errorf("ignore rule %s has an invalid until date (expected YYYY-MM-DD): %w", r, err)
+		}
+	}
+	for _, pattern := range []string{r.Purl, r.Location} {
+		// bad patterns are only reported once matching reaches them, matching the pattern against itself does
+		if _, err := doublestar.Match(pattern, pattern); errors.Is(err, doublestar.ErrBadPattern) {
+			return fmt.Errorf("ignore rule %s has an invalid glob %q", r, pattern)
+		}
+	}
+	return nil
+}
+
+// Expired reports whether the until date of the rule has passed. The until date is inclusive: the rule expires at the
+// start of the following day.
+func (r IgnoreRule) Expired(now time.Time) bool {
+	if r.Until == "" {
+		return false
+	}
+	until, err := time.Parse(eol.DateLayout, r.Until)
+	if err != nil {
+		return false
+	}
+	return !now.Before(until.AddDate(0, 0, 1))
+}
+
+// String is the string representation of the criteria of the rule
+func (r IgnoreRule) String() string {
+	var fields []string
+	for _, f := range []struct{ name, value string }{
+		{"product", r.Product},
+		{"cycle", r.Cycle},
+		{"purl", r.Purl},
+		{"type", r.Type},
+		{"location", r.Location},
+		{"until", r.Until},
+	} {
+		if f.value != "" {
+			fields = append(fields, fmt.Sprintf("%s=%q", f.name, f.value))
+		}
+	}
+	return fmt.Sprintf("IgnoreRule(%s)", strings.Join(fields, " "))
+}
+
+// appliesTo reports whether the match satisfies every criterion of the rule, regardless of its until date
+func (r IgnoreRule) appliesTo(m Match) bool {
+	if r.Product != "" && !strings.EqualFold(r.Product, m.Cycle.ProductName) {
+		return false
+	}
+	if r.Cycle != "" && r.Cycle != m.Cycle.ReleaseCycle {
+		return false
+	}
+	if r.Purl != "" && !globMatch(r.Purl, m.Package.PURL) {
+		return false
+	}
+	if r.Type != "" && !strings.EqualFold(r.Type, string(m.Package.Type)) {
+		return false
+	}
+	if r.Location != "" && !r.appliesToLocations(m) {
+		return false
+	}
+	return true
+}
+
+func (r IgnoreRule) appliesToLocations(m Match) bool {
+	for _, l := range m.Package.Locations.ToSlice() {
+		if globMatch(r.Location, l.RealPath) || globMatch(r.Location, l.AccessPath) {
+			return true
+		}
+	}
+	return false
+}
+
+func globMatch(pattern, value string) bool {
+	if value == "" {
+		return false
+	}
+	matched, err := doublestar.Match(pattern, value)
+	return err == nil && matched
+}
+
+// IgnoredBy returns the rules that waive the match as of the given time. Expired rules never apply.
+func IgnoredBy(m Match, rules []IgnoreRule, now time.Time) []IgnoreRule {
+	var applied []IgnoreRule
+	for _, r := range rules {
+		if !r.appliesTo(m) {
+			continue
+		}
+		if r.Expired(now) {
+			log.Debugf("ignore rule %s expired, not ignoring match %s", r, m)
+			continue
+		}
+		applied = append(applied, r)
+	}
+	return applied
+}
+
+// ApplyIgnoreRules splits the matches into the matches that remain and the matches waived by the rules
+func ApplyIgnoreRules(matches Matches, rules []IgnoreRule, now time.Time) (Matches, []IgnoredMatch) {
+	if len(rules) == 0 {
+		return matches, nil
+	}
+
+	remaining := NewMatches()
+	var ignored []IgnoredMatch
+	for _, m := range matches.Sorted() {
+		if applied := IgnoredBy(m, rules, now); len(applied) > 0 {
+			ignored = append(ignored, IgnoredMatch{Match: m, AppliedIgnoreRules: applied})
+			continue
+		}
+		remaining.Add(m)
+	}
+	return remaining, ignored
+}
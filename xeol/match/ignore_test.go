@@ -0,0 +1,126 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/syft/file"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/pkg"
+)
+
+func TestIgnoredBy(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := Match{
+		Cycle: eol.Cycle{ProductName: "Node.js", ReleaseCycle: "14", Eol: "2023-04-30"},
+		Package: pkg.Package{
+			Name:      "node",
+			Version:   "14.21.3",
+			Type:      syftPkg.BinaryPkg,
+			PURL:      "pkg:generic/node@14.21.3",
+			Locations: file.NewLocationSet(file.NewVirtualLocation("/usr/local/bin/node", "/usr/local/bin/node")),
+		},
+	}
+
+	tests := []struct {
+		name    string
+		rule    IgnoreRule
+		applies bool
+	}{
+		{name: "product", rule: IgnoreRule{Product: "node.js", Reason: "r"}, applies: true},
+		{name: "other product", rule: IgnoreRule{Product: "Python", Reason: "r"}, applies: false},
+		{name: "product and cycle", rule: IgnoreRule{Product: "Node.js", Cycle: "14", Reason: "r"}, applies: true},
+		{name: "product and other cycle", rule: IgnoreRule{Product: "Node.js", Cycle: "16", Reason: "r"}, applies: false},
+		{name: "purl glob", rule: IgnoreRule{Purl: "pkg:generic/node@*", Reason: "r"}, applies: true},
+		{name: "other purl glob", rule: IgnoreRule{Purl: "pkg:npm/**", Reason: "r"}, applies: false},
+		{name: "package type", rule: IgnoreRule{Type: "binary", Reason: "r"}, applies: true},
+		{name: "other package type", rule: IgnoreRule{Type: "npm", Reason: "r"}, applies: false},
+		{name: "location glob", rule: IgnoreRule{Location: "/usr/local/**", Reason: "r"}, applies: true},
+		{name: "other location glob", rule: IgnoreRule{Location: "/opt/**", Reason: "r"}, applies: false},
+		{name: "until in the future", rule: IgnoreRule{Product: "Node.js", Reason: "r", Until: "2024-06-01"}, applies: true},
+		{name: "expired", rule: IgnoreRule{Product: "Node.js", Reason: "r", Until: "2023-12-31"}, applies: false},
+		{name: "until today", rule: IgnoreRule{Product: "Node.js", Reason: "r", Until: "2024-01-01"}, applies: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applied := IgnoredBy(m, []IgnoreRule{tt.rule}, now)
+			if tt.applies {
+				assert.Equal(t, []IgnoreRule{tt.rule}, applied)
+			} else {
+				assert.Empty(t, applied)
+			}
+		})
+	}
+}
+
+func TestIgnoreRuleExpired(t *testing.T) {
+	rule := IgnoreRule{Product: "Node.js", Reason: "r", Until: "2024-01-01"}
+	tests := []struct {
+		name    string
+		now     time.Time
+		expired bool
+	}{
+		{name: "day before", now: time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC), expired: false},
+		{name: "start of the until day", now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), expired: false},
+		{name: "end of the until day", now: time.Date(2024, 1, 1, 23, 59, 59, 0, time.UTC), expired: false},
+		{name: "day after", now: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), expired: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expired, rule.Expired(tt.now))
+		})
+	}
+	assert.False(t, IgnoreRule{Product: "Node.js", Reason: "r"}.Expired(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestIgnoreRuleValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    IgnoreRule
+		wantErr bool
+	}{
+		{name: "valid", rule: IgnoreRule{Product: "Node.js", Reason: "accepted until the migration", Until: "2024-06-01"}},
+		{name: "missing reason", rule: IgnoreRule{Product: "Node.js"}, wantErr: true},
+		{name: "blank reason", rule: IgnoreRule{Product: "Node.js", Reason: "  "}, wantErr: true},
+		{name: "no criteria", rule: IgnoreRule{Reason: "everything"}, wantErr: true},
+		{name: "invalid until", rule: IgnoreRule{Product: "Node.js", Reason: "r", Until: "next year"}, wantErr: true},
+		{name: "invalid glob", rule: IgnoreRule{Purl: "pkg:npm/[", Reason: "r"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApplyIgnoreRules(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	node := Match{
+		Cycle:   eol.Cycle{ProductName: "Node.js", ReleaseCycle: "14", Eol: "2023-04-30"},
+		Package: pkg.Package{ID: "node", Name: "node", Version: "14.21.3"},
+	}
+	python := Match{
+		Cycle:   eol.Cycle{ProductName: "Python", ReleaseCycle: "3.7", Eol: "2023-06-27"},
+		Package: pkg.Package{ID: "python", Name: "python", Version: "3.7.17"},
+	}
+	rules := []IgnoreRule{
+		{Product: "Node.js", Reason: "accepted"},
+		{Product: "Python", Reason: "expired waiver", Until: "2023-12-01"},
+	}
+
+	remaining, ignored := ApplyIgnoreRules(NewMatches(node, python), rules, now)
+
+	assert.Equal(t, []Match{python}, remaining.Sorted())
+	assert.Equal(t, []IgnoredMatch{{Match: node, AppliedIgnoreRules: rules[:1]}}, ignored)
+}
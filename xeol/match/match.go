@@ -14,6 +14,11 @@ type Match struct {
 	Cycle     eol.Cycle
 	Package   pkg.Package // The package used to search for a match.
 	VulnCount int
+	Method    Method               // The kind of lookup that produced the match.
+	Upstream  *pkg.UpstreamPackage // The upstream of the package that produced the match, if it was not the package itself.
+	Details   Details              // How the match was found.
+	Status    Status               // The lifecycle status of the cycle.
+	Window    string               // The window the cycle becomes EOL in, when the status is StatusEolWithinWindow.
 }
 
 // String is the string representation of select match fields.
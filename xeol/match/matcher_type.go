@@ -1,12 +1,32 @@
 package match
 
+import "sync"
+
 const (
 	UnknownMatcherType MatcherType = "UnknownMatcherType"
 	PackageMatcher     MatcherType = "package-matcher"
+	DistroMatcher      MatcherType = "distro-matcher"
 )
 
 var AllMatcherTypes = []MatcherType{
 	PackageMatcher,
+	DistroMatcher,
 }
 
+var matcherTypesLock sync.Mutex
+
 type MatcherType string
+
+// RegisterMatcherType adds a custom matcher type to AllMatcherTypes, alongside the built-in matcher types.
+// Registering a type that is already known has no effect.
+func RegisterMatcherType(t MatcherType) {
+	matcherTypesLock.Lock()
+	defer matcherTypesLock.Unlock()
+
+	for _, existing := range AllMatcherTypes {
+		if existing == t {
+			return
+		}
+	}
+	AllMatcherTypes = append(AllMatcherTypes, t)
+}
@@ -81,3 +81,14 @@ func (r *Matches) Sorted() []Match {
 
 	return matches
 }
+
+// Eol returns the matches that are EOL, either already or within a window
+func (r *Matches) Eol() Matches {
+	eol := newMatches()
+	for _, m := range r.Sorted() {
+		if m.Status.IsEol() {
+			eol.Add(m)
+		}
+	}
+	return eol
+}
@@ -0,0 +1,11 @@
+package match
+
+// Method is the kind of lookup that produced a match
+type Method string
+
+const (
+	UnknownMethod   Method = ""
+	PURLMethod      Method = "purl"
+	CPEMethod       Method = "cpe"
+	DistroCPEMethod Method = "distro-cpe"
+)
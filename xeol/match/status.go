@@ -0,0 +1,176 @@
+package match
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+)
+
+// Status is the lifecycle status of the cycle of a match
+type Status string
+
+const (
+	// StatusEol is used for cycles that are end-of-life
+	StatusEol Status = "eol"
+	// StatusEolWithinWindow is used for cycles that become end-of-life within one of the configured windows
+	StatusEolWithinWindow Status = "eol-within-window"
+	// StatusSupported is used for cycles that are not end-of-life within any of the configured windows
+	StatusSupported Status = "supported"
+	// StatusUnknownCycle is used for packages of a known product whose version does not match any cycle
+	StatusUnknownCycle Status = "unknown-cycle"
+	// StatusNoEolData is used for cycles that have no EOL date
+	StatusNoEolData Status = "no-eol-data"
+)
+
+var AllStatuses = []Status{
+	StatusEol,
+	StatusEolWithinWindow,
+	StatusSupported,
+	StatusUnknownCycle,
+	StatusNoEolData,
+}
+
+// DefaultStatuses are the statuses reported when no statuses are configured
+var DefaultStatuses = []Status{
+	StatusEol,
+	StatusEolWithinWindow,
+}
+
+// InventoryStatuses are the statuses of every package whose version resolves to a cycle, regardless of its EOL date
+var InventoryStatuses = []Status{
+	StatusEol,
+	StatusEolWithinWindow,
+	StatusSupported,
+	StatusNoEolData,
+}
+
+// ParseStatus returns the status for the given (case-insensitive) name
+func ParseStatus(s string) (Status, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	for _, status := range AllStatuses {
+		if string(status) == name {
+			return status, nil
+		}
+	}
+	return "", fmt.Errorf("unknown status %q (available: %s)", s, strings.Join(statusNames(AllStatuses), ", "))
+}
+
+// IsEol reports whether the status is end-of-life, either already or within a window
+func (s Status) IsEol() bool {
+	return s == StatusEol || s == StatusEolWithinWindow
+}
+
+// Window is a period of time from now in which cycles that become end-of-life are reported as
+// StatusEolWithinWindow, e.g. "90d"
+type Window struct {
+	Name string
+	End  time.Time
+}
+
+// StatusConfig determines the lifecycle status of matches and which statuses are reported
+type StatusConfig struct {
+	Now      time.Time // the time used to classify matches, time.Now() when zero
+	Windows  []Window
+	Statuses []Status // the statuses reported, DefaultStatuses when empty
+}
+
+func (c StatusConfig) now() time.Time {
+	if c.Now.IsZero() {
+		return time.Now()
+	}
+	return c.Now
+}
+
+// MatchDate is the end of the largest window, which is the date cycles must be EOL by to be reported as EOL
+func (c StatusConfig) MatchDate() time.Time {
+	date := c.now()
+	for _, w := range c.Windows {
+		if w.End.After(date) {
+			date = w.End
+		}
+	}
+	return date
+}
+
+// Classify returns the lifecycle status of a cycle, along with the name of the smallest window the EOL date
+// falls in when the status is StatusEolWithinWindow
+func (c StatusConfig) Classify(cycle eol.Cycle) (Status, string) {
+	switch {
+	case cycle.ReleaseCycle == "":
+		return StatusUnknownCycle, ""
+	case cycle.EolBool:
+		return StatusEol, ""
+	case !cycle.HasEolDate():
+		return StatusNoEolData, ""
+	}
+
+	eolDate, err := time.Parse(eol.DateLayout, cycle.Eol)
+	if err != nil {
+		return StatusNoEolData, ""
+	}
+
+	now := c.now()
+	if now.After(eolDate) {
+		return StatusEol, ""
+	}
+
+	windows := make([]Window, len(c.Windows))
+	copy(windows, c.Windows)
+	sort.SliceStable(windows, func(i, j int) bool {
+		return windows[i].End.Before(windows[j].End)
+	})
+	for _, w := range windows {
+		if w.End.After(eolDate) {
+			return StatusEolWithinWindow, w.Name
+		}
+	}
+	return StatusSupported, ""
+}
+
+// Reports reports whether matches with the given status are reported
+func (c StatusConfig) Reports(s Status) bool {
+	statuses := c.Statuses
+	if len(statuses) == 0 {
+		statuses = DefaultStatuses
+	}
+	for _, status := range statuses {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusAt returns the lifecycle status of the match. Matches that were not classified while matching are
+// classified as of the given time, treating every future EOL date as being within the window they were found in.
+func (m Match) StatusAt(now time.Time) Status {
+	if m.Status != "" {
+		return m.Status
+	}
+	status, _ := StatusConfig{
+		Now:     now,
+		Windows: []Window{{End: time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)}},
+	}.Classify(m.Cycle)
+	return status
+}
+
+func statusNames(statuses []Status) []string {
+	names := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		names = append(names, string(s))
+	}
+	return names
+}
+
+// StatusLabel returns the lifecycle status of the match for display, including the window the cycle becomes EOL
+// in, e.g. "eol-within-window (90d)"
+func (m Match) StatusLabel(now time.Time) string {
+	status := m.StatusAt(now)
+	if status == StatusEolWithinWindow && m.Window != "" {
+		return fmt.Sprintf("%s (%s)", status, m.Window)
+	}
+	return string(status)
+}
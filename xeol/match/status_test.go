@@ -0,0 +1,90 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+)
+
+func TestStatusConfigClassify(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cfg := StatusConfig{
+		Now: now,
+		// windows are not required to be sorted
+		Windows: []Window{
+			{Name: "1y", End: now.AddDate(1, 0, 0)},
+			{Name: "30d", End: now.AddDate(0, 0, 30)},
+			{Name: "90d", End: now.AddDate(0, 0, 90)},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		cycle          eol.Cycle
+		expectedStatus Status
+		expectedWindow string
+	}{
+		{name: "eol by date", cycle: eol.Cycle{ReleaseCycle: "1", Eol: "2023-06-01"}, expectedStatus: StatusEol},
+		{name: "eol by bool", cycle: eol.Cycle{ReleaseCycle: "1", EolBool: true}, expectedStatus: StatusEol},
+		{name: "within the smallest window", cycle: eol.Cycle{ReleaseCycle: "1", Eol: "2024-01-15"}, expectedStatus: StatusEolWithinWindow, expectedWindow: "30d"},
+		{name: "within a larger window", cycle: eol.Cycle{ReleaseCycle: "1", Eol: "2024-03-01"}, expectedStatus: StatusEolWithinWindow, expectedWindow: "90d"},
+		{name: "within the largest window", cycle: eol.Cycle{ReleaseCycle: "1", Eol: "2024-10-01"}, expectedStatus: StatusEolWithinWindow, expectedWindow: "1y"},
+		{name: "supported", cycle: eol.Cycle{ReleaseCycle: "1", Eol: "2026-01-01"}, expectedStatus: StatusSupported},
+		{name: "no eol date", cycle: eol.Cycle{ReleaseCycle: "1"}, expectedStatus: StatusNoEolData},
+		{name: "zero eol date reported by the store", cycle: eol.Cycle{ReleaseCycle: "1", Eol: "0001-01-01"}, expectedStatus: StatusNoEolData},
+		{name: "invalid eol date", cycle: eol.Cycle{ReleaseCycle: "1", Eol: "soon"}, expectedStatus: StatusNoEolData},
+		{name: "unknown cycle", cycle: eol.Cycle{ProductName: "Node.js"}, expectedStatus: StatusUnknownCycle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, window := cfg.Classify(tt.cycle)
+			assert.Equal(t, tt.expectedStatus, status)
+			assert.Equal(t, tt.expectedWindow, window)
+		})
+	}
+}
+
+func TestStatusConfigMatchDate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, now, StatusConfig{Now: now}.MatchDate())
+	assert.Equal(t, now.AddDate(1, 0, 0), StatusConfig{
+		Now: now,
+		Windows: []Window{
+			{Name: "1y", End: now.AddDate(1, 0, 0)},
+			{Name: "90d", End: now.AddDate(0, 0, 90)},
+		},
+	}.MatchDate())
+}
+
+func TestStatusConfigReports(t *testing.T) {
+	assert.True(t, StatusConfig{}.Reports(StatusEol))
+	assert.True(t, StatusConfig{}.Reports(StatusEolWithinWindow))
+	assert.False(t, StatusConfig{}.Reports(StatusSupported))
+
+	cfg := StatusConfig{Statuses: []Status{StatusSupported}}
+	assert.True(t, cfg.Reports(StatusSupported))
+	assert.False(t, cfg.Reports(StatusEol))
+}
+
+func TestParseStatus(t *testing.T) {
+	status, err := ParseStatus(" EOL-Within-Window ")
+	require.NoError(t, err)
+	assert.Equal(t, StatusEolWithinWindow, status)
+
+	_, err = ParseStatus("dead")
+	assert.Error(t, err)
+}
+
+func TestMatchStatusAt(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, StatusSupported, Match{Status: StatusSupported, Cycle: eol.Cycle{ReleaseCycle: "1", Eol: "2020-01-01"}}.StatusAt(now))
+	assert.Equal(t, StatusEol, Match{Cycle: eol.Cycle{ReleaseCycle: "1", Eol: "2020-01-01"}}.StatusAt(now))
+	assert.Equal(t, StatusEolWithinWindow, Match{Cycle: eol.Cycle{ReleaseCycle: "1", Eol: "2030-01-01"}}.StatusAt(now))
+}
@@ -5,8 +5,10 @@ import (
 
 	"github.com/anchore/syft/syft/linux"
 
+	"github.com/xeol-io/xeol/internal/log"
 	"github.com/xeol-io/xeol/xeol/eol"
 	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
 	"github.com/xeol-io/xeol/xeol/search"
 )
 
@@ -18,16 +20,38 @@ type MatcherConfig struct {
 	UseCPEs bool
 }
 
-func NewPackageMatcher(cfg MatcherConfig) *Matcher {
+func NewDistroMatcher(cfg MatcherConfig) *Matcher {
 	return &Matcher{
 		UseCPEs: cfg.UseCPEs,
 	}
 }
 
+// NewPackageMatcher creates a distro matcher.
+//
+// Deprecated: use NewDistroMatcher instead.
+func NewPackageMatcher(cfg MatcherConfig) *Matcher {
+	return NewDistroMatcher(cfg)
+}
+
 func (m *Matcher) Type() match.MatcherType {
-	return match.PackageMatcher
+	return match.DistroMatcher
+}
+
+// Match does not match individual packages, the distro is matched once per scan by MatchDistro
+func (m *Matcher) Match(_ eol.Provider, _ *linux.Release, _ pkg.Package, _ time.Time) ([]match.Match, error) {
+	return nil, nil
 }
 
-func (m *Matcher) Match(store eol.Provider, d *linux.Release, eolMatchDate time.Time) (match.Match, string, error) {
-	return search.ByDistroCpe(store, d, eolMatchDate)
+func (m *Matcher) MatchDistro(store eol.Provider, d *linux.Release, eolMatchDate time.Time) ([]match.Match, error) {
+	if !m.UseCPEs || d == nil {
+		return nil, nil
+	}
+
+	distroMatch, distroCPE, err := search.ByDistroCpe(store, d, eolMatchDate)
+	if err != nil || (distroMatch.Cycle == eol.Cycle{}) {
+		return nil, err
+	}
+
+	log.Debugf("found eol match for distro cpe=%s", distroCPE)
+	return []match.Match{distroMatch}, nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/xeol-io/xeol/xeol/eol"
 	"github.com/xeol-io/xeol/xeol/match"
 	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/search"
 )
 
 type mockStore struct {
@@ -25,6 +26,16 @@ func (s *mockStore) GetCyclesByPurl(purl string) ([]xeolDB.Cycle, error) {
 	return s.backend[purl], nil
 }
 
+func (s *mockStore) GetCyclesByPurls(purls []string) (map[string][]xeolDB.Cycle, error) {
+	cycles := make(map[string][]xeolDB.Cycle)
+	for _, purl := range purls {
+		if c, ok := s.backend[purl]; ok {
+			cycles[purl] = c
+		}
+	}
+	return cycles, nil
+}
+
 func (s *mockStore) GetCyclesByCpe(cpe string) ([]xeolDB.Cycle, error) {
 	return s.backend[cpe], nil
 }
@@ -33,6 +44,10 @@ func (s *mockStore) GetVulnCountByPurlAndVersion(purl string, version string) (i
 	return 0, nil
 }
 
+func (s *mockStore) GetVulnCountsByPurls(_ []string) (map[string]map[string]int, error) {
+	return nil, nil
+}
+
 func (s *mockStore) GetAllProducts() (*[]xeolDB.Product, error) {
 	return nil, nil
 }
@@ -55,7 +70,7 @@ func TestMatch(t *testing.T) {
 	provider, err := db.NewEolProvider(&store)
 	require.NoError(t, err)
 
-	m := Matcher{}
+	m := Matcher{UseCPEs: true}
 	p := pkg.Package{
 		ID:      "",
 		Name:    "Fedora",
@@ -63,6 +78,7 @@ func TestMatch(t *testing.T) {
 		Type:    "os",
 	}
 
+	now := time.Now()
 	cycleFound, err := eol.NewCycle(cycle)
 	d := &linux.Release{
 		ID:      "fedora",
@@ -74,10 +90,26 @@ func TestMatch(t *testing.T) {
 	expected := match.Match{
 		Cycle:   *cycleFound,
 		Package: p,
+		Method:  match.DistroCPEMethod,
+		Details: match.Details{{
+			Type:    match.DistroCPEMethod,
+			Matcher: match.DistroMatcher,
+			SearchedBy: search.Parameters{
+				Query:             "cpe:/o:fedoraproject:fedora",
+				Version:           "29",
+				NormalizedVersion: "29",
+				MatchDate:         now.Format(eol.DateLayout),
+			},
+			Found: search.Result{
+				CandidateCycles: []string{"29"},
+				Cycle:           "29",
+				ProductName:     "Fedora",
+			},
+		}},
 	}
-	actual, _, err := m.Match(provider, d, time.Now())
+	actual, err := m.MatchDistro(provider, d, now)
 	assert.NoError(t, err)
-	assertMatches(t, expected, actual)
+	assertMatches(t, []match.Match{expected}, actual)
 }
 
 func TestMatchCpeMismatch(t *testing.T) {
@@ -94,7 +126,7 @@ func TestMatchCpeMismatch(t *testing.T) {
 			"cpe:/o:canonical:ubuntu": {cycle},
 		},
 	}
-	m := Matcher{}
+	m := Matcher{UseCPEs: true}
 	provider, err := db.NewEolProvider(&store)
 	require.NoError(t, err)
 
@@ -105,9 +137,9 @@ func TestMatchCpeMismatch(t *testing.T) {
 		CPEName: "cpe:/o:fedoraproject:fedora:29",
 	}
 
-	actual, _, err := m.Match(provider, d, time.Now())
+	actual, err := m.MatchDistro(provider, d, time.Now())
 	assert.NoError(t, err)
-	assertMatches(t, match.Match{}, actual)
+	assertMatches(t, nil, actual)
 }
 
 func TestMatchNoMatchingVersion(t *testing.T) {
@@ -129,7 +161,7 @@ func TestMatchNoMatchingVersion(t *testing.T) {
 	require.NoError(t, err)
 
 	// Set up a matcher and a package with the same PURL but a different version
-	m := Matcher{}
+	m := Matcher{UseCPEs: true}
 	d := &linux.Release{
 		ID:      "fedora",
 		Name:    "Fedora",
@@ -137,9 +169,12 @@ func TestMatchNoMatchingVersion(t *testing.T) {
 		CPEName: "cpe:/o:fedoraproject:fedora:29",
 	}
 
-	actual, _, err := m.Match(provider, d, time.Now())
+	// the product is known, but the version does not match any of its cycles
+	actual, err := m.MatchDistro(provider, d, time.Now())
 	assert.NoError(t, err)
-	assertMatches(t, match.Match{}, actual)
+	require.Len(t, actual, 1)
+	assert.Equal(t, match.StatusUnknownCycle, actual[0].Status)
+	assert.Equal(t, eol.Cycle{ProductName: "Fedora"}, actual[0].Cycle)
 }
 
 func TestMatchTimeChange(t *testing.T) {
@@ -160,7 +195,7 @@ func TestMatchTimeChange(t *testing.T) {
 	provider, err := db.NewEolProvider(&store)
 	require.NoError(t, err)
 
-	m := Matcher{}
+	m := Matcher{UseCPEs: true}
 	d := &linux.Release{
 		ID:      "fedora",
 		Name:    "Fedora",
@@ -171,12 +206,47 @@ func TestMatchTimeChange(t *testing.T) {
 	eolMatchTime, err := time.Parse("2006-01-02", "2018-01-01")
 	assert.NoError(t, err)
 
-	actual, _, err := m.Match(provider, d, eolMatchTime)
+	// the cycle is matched regardless of the match date, it is only classified as supported as of that date
+	actual, err := m.MatchDistro(provider, d, eolMatchTime)
+	assert.NoError(t, err)
+	require.Len(t, actual, 1)
+	status, _ := match.StatusConfig{Now: eolMatchTime}.Classify(actual[0].Cycle)
+	assert.Equal(t, match.StatusSupported, status)
+}
+
+func TestMatchWithoutCPEs(t *testing.T) {
+	cycle := xeolDB.Cycle{
+		ProductName:       "Fedora",
+		ReleaseDate:       "2019-11-26",
+		ReleaseCycle:      "29",
+		Eol:               "2019-11-26",
+		LatestReleaseDate: "2019-11-26",
+	}
+
+	store := mockStore{
+		backend: map[string][]xeolDB.Cycle{
+			"cpe:/o:fedoraproject:fedora": {cycle},
+		},
+	}
+
+	provider, err := db.NewEolProvider(&store)
+	require.NoError(t, err)
+
+	// matching by CPE is disabled in the configuration
+	m := NewDistroMatcher(MatcherConfig{UseCPEs: false})
+	d := &linux.Release{
+		ID:      "fedora",
+		Name:    "Fedora",
+		Version: "29",
+		CPEName: "cpe:/o:fedoraproject:fedora:29",
+	}
+
+	actual, err := m.MatchDistro(provider, d, time.Now())
 	assert.NoError(t, err)
-	assertMatches(t, match.Match{}, actual)
+	assertMatches(t, nil, actual)
 }
 
-func assertMatches(t *testing.T, expected, actual match.Match) {
+func assertMatches(t *testing.T, expected, actual []match.Match) {
 	t.Helper()
 	var opts = []cmp.Option{
 		cmpopts.IgnoreFields(pkg.Package{}, "Locations"),
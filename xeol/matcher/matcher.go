@@ -1,9 +1,26 @@
 package matcher
 
 import (
+	"time"
+
+	"github.com/anchore/syft/syft/linux"
+
+	"github.com/xeol-io/xeol/xeol/eol"
 	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
 )
 
+// Matcher finds EOL matches for the packages of a scanned source. Packages are matched concurrently, so
+// implementations must be safe for concurrent use.
 type Matcher interface {
 	Type() match.MatcherType
+	// Match returns the EOL matches for the given package. The distro of the scanned source is given
+	// for context and may be nil.
+	Match(store eol.Provider, d *linux.Release, p pkg.Package, eolMatchDate time.Time) ([]match.Match, error)
+}
+
+// DistroMatcher is implemented by matchers that also find EOL matches for the distro of the scanned
+// source itself. MatchDistro is called once per scan.
+type DistroMatcher interface {
+	MatchDistro(store eol.Provider, d *linux.Release, eolMatchDate time.Time) ([]match.Match, error)
 }
@@ -1,6 +1,9 @@
 package matcher
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"time"
 
 	"github.com/anchore/syft/syft/linux"
@@ -28,13 +31,69 @@ type Config struct {
 	Distro   distroMatcher.MatcherConfig
 }
 
-func NewDefaultMatchers(_ Config) []Matcher {
-	return []Matcher{
-		&pkgMatcher.Matcher{},
-		&distroMatcher.Matcher{},
+// DefaultConfig returns the configuration used when no matchers are given to FindMatches
+func DefaultConfig() Config {
+	return Config{
+		Packages: pkgMatcher.MatcherConfig{UsePURLs: true, UseCPEs: true},
+		Distro:   distroMatcher.MatcherConfig{UseCPEs: true},
 	}
 }
 
+// Factory creates a Matcher from the matcher configuration
+type Factory func(Config) Matcher
+
+type registration struct {
+	matcherType match.MatcherType
+	factory     Factory
+}
+
+var (
+	registryLock sync.Mutex
+	registry     = []registration{
+		{
+			matcherType: match.PackageMatcher,
+			factory: func(cfg Config) Matcher {
+				return pkgMatcher.NewPackageMatcher(cfg.Packages)
+			},
+		},
+		{
+			matcherType: match.DistroMatcher,
+			factory: func(cfg Config) Matcher {
+				return distroMatcher.NewDistroMatcher(cfg.Distro)
+			},
+		},
+	}
+)
+
+// Register adds a custom matcher type to the matchers created by NewDefaultMatchers, alongside the
+// built-in package and distro matchers. Registering a matcher type twice is an error.
+func Register(t match.MatcherType, f Factory) error {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	for _, r := range registry {
+		if r.matcherType == t {
+			return fmt.Errorf("matcher type %q is already registered", t)
+		}
+	}
+
+	registry = append(registry, registration{matcherType: t, factory: f})
+	match.RegisterMatcherType(t)
+	return nil
+}
+
+// NewDefaultMatchers creates all registered matchers from the given configuration
+func NewDefaultMatchers(cfg Config) []Matcher {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	matchers := make([]Matcher, 0, len(registry))
+	for _, r := range registry {
+		matchers = append(matchers, r.factory(cfg))
+	}
+	return matchers
+}
+
 type monitorWriter struct {
 	PackagesProcessed *progress.Manual
 	MatchesDiscovered *progress.Manual
@@ -67,50 +126,146 @@ func (m *monitorWriter) SetCompleted() {
 	m.MatchesDiscovered.SetCompleted()
 }
 
+// FindMatches returns all matches for the distro and the given packages with one of the statuses reported by
+// the status configuration. Packages are matched concurrently by the given number of workers (see StreamMatches).
 func FindMatches(store interface {
 	eol.Provider
-}, distro *linux.Release, _ []Matcher, packages []pkg.Package, _ bool, eolMatchDate time.Time) match.Matches {
+}, distro *linux.Release, matchers []Matcher, packages []pkg.Package, failOnEolFound bool, statusCfg match.StatusConfig, workers int) match.Matches {
 	res := match.NewMatches()
-	defaultMatcher := &pkgMatcher.Matcher{
-		UsePURLs: true,
+	StreamMatches(store, distro, matchers, packages, failOnEolFound, statusCfg, workers, func(m match.Match) {
+		res.Add(m)
+	})
+	return res
+}
+
+// StreamMatches calls onMatch for every match of the distro and the given packages as soon as it is found,
+// without collecting the matches. Matches are classified with the status configuration, and only matches with
+// a reported status are passed on. It returns the number of matches passed on. All registered matchers (see
+// NewDefaultMatchers) with the default configuration are used when no matchers are given.
+//
+// Packages are matched concurrently by the given number of workers (the number of CPUs when workers < 1), so
+// matchers and the store must be safe for concurrent use. onMatch is never called concurrently, and is called
+// in the order of the packages regardless of the number of workers.
+func StreamMatches(store interface {
+	eol.Provider
+}, distro *linux.Release, matchers []Matcher, packages []pkg.Package, _ bool, statusCfg match.StatusConfig, workers int, onMatch func(match.Match)) int {
+	if len(matchers) == 0 {
+		matchers = NewDefaultMatchers(DefaultConfig())
 	}
-	distroMatcher := &distroMatcher.Matcher{
-		UseCPEs: true,
+	eolMatchDate := statusCfg.MatchDate()
+
+	if prefetcher, ok := store.(eol.Prefetcher); ok {
+		if err := prefetcher.Prefetch(packages); err != nil {
+			// matching falls back to querying the provider for each package
+			log.Debugf("unable to prefetch eol data for %d packages: %+v", len(packages), err)
+		}
 	}
 
+	var count int
 	progressMonitor := trackMatcher(len(packages))
 	defer progressMonitor.SetCompleted()
 
-	distroMatch, distroCPE, err := distroMatcher.Match(store, distro, eolMatchDate)
-	if err != nil {
-		log.Debugf("matcher failed for distro=%s: %+v", distro, err)
-	}
-	if (distroMatch.Cycle != eol.Cycle{}) {
-		logDistroMatch(distroCPE)
-		res.Add(distroMatch)
-		progressMonitor.MatchesDiscovered.Increment()
-	}
-
-	for _, p := range packages {
-		progressMonitor.PackagesProcessed.Increment()
-		log.Debugf("searching for eol matches for pkg=%s", p)
+	for _, m := range matchers {
+		dm, ok := m.(DistroMatcher)
+		if !ok {
+			continue
+		}
 
-		pkgMatch, err := defaultMatcher.Match(store, p, eolMatchDate)
+		distroMatches, err := dm.MatchDistro(store, distro, eolMatchDate)
 		if err != nil {
-			log.Debugf("matcher failed for pkg=%s: %+v", p, err)
+			log.Debugf("matcher=%s failed for distro=%s: %+v", m.Type(), distro, err)
+			continue
 		}
-		if (pkgMatch.Cycle != eol.Cycle{}) {
-			logPkgMatch(p)
-			res.Add(pkgMatch)
+		for _, distroMatch := range distroMatches {
+			if !classify(&distroMatch, statusCfg) {
+				continue
+			}
+			onMatch(distroMatch)
+			count++
 			progressMonitor.MatchesDiscovered.Increment()
 		}
 	}
 
-	return res
+	for pkgMatches := range matchPackages(store, distro, matchers, packages, eolMatchDate, workers, progressMonitor) {
+		for _, pkgMatch := range pkgMatches {
+			if !classify(&pkgMatch, statusCfg) {
+				continue
+			}
+			logPkgMatch(pkgMatch.Package)
+			onMatch(pkgMatch)
+			count++
+			progressMonitor.MatchesDiscovered.Increment()
+		}
+	}
+
+	return count
 }
 
-func logDistroMatch(distroCPE string) {
-	log.Debugf("found eol match for distro cpe=%s \n", distroCPE)
+// matchPackages matches the packages with a pool of workers, and returns the matches of each package in the
+// order of the packages
+func matchPackages(store eol.Provider, distro *linux.Release, matchers []Matcher, packages []pkg.Package, eolMatchDate time.Time, workers int, progressMonitor *monitorWriter) <-chan []match.Match {
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	workers = min(workers, max(len(packages), 1))
+
+	// each package has its own (buffered) result channel, so that workers never wait on each other and the
+	// results can be read in order
+	results := make([]chan []match.Match, len(packages))
+	for i := range results {
+		results[i] = make(chan []match.Match, 1)
+	}
+
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for i := range packages {
+			jobs <- i
+		}
+	}()
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			for i := range jobs {
+				results[i] <- matchPackage(store, distro, matchers, packages[i], eolMatchDate)
+				progressMonitor.PackagesProcessed.Increment()
+			}
+		}()
+	}
+
+	ordered := make(chan []match.Match)
+	go func() {
+		defer close(ordered)
+		for _, result := range results {
+			ordered <- <-result
+		}
+	}()
+	return ordered
+}
+
+// matchPackage returns the matches of all matchers for the given package
+func matchPackage(store eol.Provider, distro *linux.Release, matchers []Matcher, p pkg.Package, eolMatchDate time.Time) []match.Match {
+	log.Debugf("searching for eol matches for pkg=%s", p)
+
+	var matches []match.Match
+	for _, m := range matchers {
+		pkgMatches, err := m.Match(store, distro, p, eolMatchDate)
+		if err != nil {
+			log.Debugf("matcher=%s failed for pkg=%s: %+v", m.Type(), p, err)
+			continue
+		}
+		matches = append(matches, pkgMatches...)
+	}
+	return matches
+}
+
+// classify sets the lifecycle status of the match, unless the matcher already did, and reports whether the
+// status is one of the reported statuses
+func classify(m *match.Match, statusCfg match.StatusConfig) bool {
+	if m.Status == "" {
+		m.Status, m.Window = statusCfg.Classify(m.Cycle)
+	}
+	return statusCfg.Reports(m.Status)
 }
 
 func logPkgMatch(p pkg.Package) {
@@ -0,0 +1,251 @@
+package matcher
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/syft/linux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+)
+
+type mockProvider struct {
+	cycles map[string][]eol.Cycle
+}
+
+func (m *mockProvider) GetByPackagePurl(p pkg.Package) ([]eol.Cycle, error) {
+	return m.cycles[p.PURL], nil
+}
+
+func (m *mockProvider) GetByPackageCpe(_ pkg.Package) ([]eol.Cycle, string, error) {
+	return nil, "", nil
+}
+
+func (m *mockProvider) GetVulnCount(_ pkg.Package) (int, error) {
+	return 0, nil
+}
+
+func (m *mockProvider) GetByDistroCpe(_ *linux.Release) (string, []eol.Cycle, string, error) {
+	return "29", m.cycles["cpe:/o:fedoraproject:fedora"], "cpe:/o:fedoraproject:fedora", nil
+}
+
+// mockMatcher matches every package with the given cycle
+type mockMatcher struct {
+	cycle eol.Cycle
+}
+
+func (m *mockMatcher) Type() match.MatcherType {
+	return "mock-matcher"
+}
+
+func (m *mockMatcher) Match(_ eol.Provider, _ *linux.Release, p pkg.Package, _ time.Time) ([]match.Match, error) {
+	return []match.Match{{Cycle: m.cycle, Package: p}}, nil
+}
+
+func TestFindMatches(t *testing.T) {
+	store := &mockProvider{
+		cycles: map[string][]eol.Cycle{
+			"pkg:npm/node@14.0.0":         {{ProductName: "Node.js", ReleaseCycle: "14", Eol: "2023-04-30"}},
+			"cpe:/o:fedoraproject:fedora": {{ProductName: "Fedora", ReleaseCycle: "29", Eol: "2019-11-26"}},
+		},
+	}
+	distro := &linux.Release{ID: "fedora", Name: "Fedora", VersionID: "29", CPEName: "cpe:/o:fedoraproject:fedora:29"}
+	packages := []pkg.Package{
+		{ID: "node", Name: "node", Version: "14.0.0", PURL: "pkg:npm/node@14.0.0"},
+	}
+	statusCfg := match.StatusConfig{Now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	cases := []struct {
+		name     string
+		matchers []Matcher
+		expected []string
+	}{
+		{
+			name:     "default matchers",
+			matchers: nil,
+			expected: []string{"Fedora", "Node.js"},
+		},
+		{
+			name: "purl matching disabled",
+			matchers: NewDefaultMatchers(Config{
+				Distro: DefaultConfig().Distro,
+			}),
+			expected: []string{"Fedora"},
+		},
+		{
+			name: "cpe matching disabled",
+			matchers: NewDefaultMatchers(Config{
+				Packages: DefaultConfig().Packages,
+			}),
+			expected: []string{"Node.js"},
+		},
+		{
+			name:     "custom matcher",
+			matchers: []Matcher{&mockMatcher{cycle: eol.Cycle{ProductName: "Custom", ReleaseCycle: "1", Eol: "2020-01-01"}}},
+			expected: []string{"Custom"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches := FindMatches(store, distro, tc.matchers, packages, false, statusCfg, 2)
+
+			var products []string
+			for _, m := range matches.Sorted() {
+				products = append(products, m.Cycle.ProductName)
+			}
+			assert.ElementsMatch(t, tc.expected, products)
+		})
+	}
+}
+
+func TestFindMatchesStatuses(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	nodeCycles := []eol.Cycle{
+		{ProductName: "Node.js", ReleaseCycle: "14", Eol: "2023-04-30"},
+		{ProductName: "Node.js", ReleaseCycle: "18", Eol: "2024-03-01"},
+		{ProductName: "Node.js", ReleaseCycle: "20", Eol: "2024-09-01"},
+		{ProductName: "Node.js", ReleaseCycle: "21", Eol: "2026-04-30"},
+		{ProductName: "Node.js", ReleaseCycle: "22"},
+	}
+	store := &mockProvider{
+		cycles: map[string][]eol.Cycle{
+			"pkg:npm/node@14.0.0": nodeCycles,
+			"pkg:npm/node@18.0.0": nodeCycles,
+			"pkg:npm/node@20.0.0": nodeCycles,
+			"pkg:npm/node@21.0.0": nodeCycles,
+			"pkg:npm/node@22.0.0": nodeCycles,
+			"pkg:npm/node@99.0.0": nodeCycles,
+		},
+	}
+	var packages []pkg.Package
+	for _, v := range []string{"14", "18", "20", "21", "22", "99"} {
+		packages = append(packages, pkg.Package{
+			ID:      pkg.ID("node-" + v),
+			Name:    "node",
+			Version: v + ".0.0",
+			PURL:    "pkg:npm/node@" + v + ".0.0",
+		})
+	}
+	windows := []match.Window{
+		{Name: "1y", End: now.AddDate(1, 0, 0)},
+		{Name: "90d", End: now.AddDate(0, 0, 90)},
+	}
+
+	cases := []struct {
+		name     string
+		statuses []match.Status
+		expected map[string]string
+	}{
+		{
+			name: "default statuses",
+			expected: map[string]string{
+				"14.0.0": "eol",
+				"18.0.0": "eol-within-window 90d",
+				"20.0.0": "eol-within-window 1y",
+			},
+		},
+		{
+			name:     "all statuses",
+			statuses: match.AllStatuses,
+			expected: map[string]string{
+				"14.0.0": "eol",
+				"18.0.0": "eol-within-window 90d",
+				"20.0.0": "eol-within-window 1y",
+				"21.0.0": "supported",
+				"22.0.0": "no-eol-data",
+				"99.0.0": "unknown-cycle",
+			},
+		},
+		{
+			name:     "inventory statuses",
+			statuses: match.InventoryStatuses,
+			expected: map[string]string{
+				"14.0.0": "eol",
+				"18.0.0": "eol-within-window 90d",
+				"20.0.0": "eol-within-window 1y",
+				"21.0.0": "supported",
+				"22.0.0": "no-eol-data",
+			},
+		},
+		{
+			name:     "only supported",
+			statuses: []match.Status{match.StatusSupported},
+			expected: map[string]string{
+				"21.0.0": "supported",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			statusCfg := match.StatusConfig{Now: now, Windows: windows, Statuses: tc.statuses}
+			matches := FindMatches(store, nil, nil, packages, false, statusCfg, 2)
+
+			actual := make(map[string]string)
+			for _, m := range matches.Sorted() {
+				actual[m.Package.Version] = strings.TrimSpace(string(m.Status) + " " + m.Window)
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestStreamMatchesWorkers(t *testing.T) {
+	store := &mockProvider{cycles: map[string][]eol.Cycle{}}
+	var packages []pkg.Package
+	for i := 0; i < 200; i++ {
+		purl := fmt.Sprintf("pkg:npm/node-%d@14.0.0", i)
+		store.cycles[purl] = []eol.Cycle{{ProductName: fmt.Sprintf("Node.js %d", i), ReleaseCycle: "14", Eol: "2023-04-30"}}
+		packages = append(packages, pkg.Package{ID: pkg.ID(purl), Name: "node", Version: "14.0.0", PURL: purl})
+	}
+	statusCfg := match.StatusConfig{Now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	var expected []string
+	for _, p := range packages {
+		expected = append(expected, p.PURL)
+	}
+
+	for _, workers := range []int{0, 1, 8, 500} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			var actual []string
+			count := StreamMatches(store, nil, nil, packages, false, statusCfg, workers, func(m match.Match) {
+				actual = append(actual, m.Package.PURL)
+			})
+
+			assert.Equal(t, len(packages), count)
+			// matches are streamed in the order of the packages, regardless of the number of workers
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	custom := match.MatcherType("mock-matcher")
+	require.NoError(t, Register(custom, func(_ Config) Matcher {
+		return &mockMatcher{}
+	}))
+	t.Cleanup(func() {
+		registry = registry[:len(registry)-1]
+	})
+
+	assert.Error(t, Register(custom, func(_ Config) Matcher {
+		return &mockMatcher{}
+	}))
+	assert.Error(t, Register(match.PackageMatcher, func(_ Config) Matcher {
+		return &mockMatcher{}
+	}))
+
+	var types []match.MatcherType
+	for _, m := range NewDefaultMatchers(DefaultConfig()) {
+		types = append(types, m.Type())
+	}
+	assert.Equal(t, []match.MatcherType{match.PackageMatcher, match.DistroMatcher, custom}, types)
+	assert.Contains(t, match.AllMatcherTypes, custom)
+}
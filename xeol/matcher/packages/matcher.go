@@ -3,8 +3,10 @@ package packages
 import (
 	"time"
 
+	"github.com/anchore/syft/syft/linux"
 	syftPkg "github.com/anchore/syft/syft/pkg"
 
+	"github.com/xeol-io/xeol/internal/log"
 	"github.com/xeol-io/xeol/xeol/eol"
 	"github.com/xeol-io/xeol/xeol/match"
 	"github.com/xeol-io/xeol/xeol/pkg"
@@ -13,15 +15,18 @@ import (
 
 type Matcher struct {
 	UsePURLs bool
+	UseCPEs  bool
 }
 
 type MatcherConfig struct {
 	UsePURLs bool
+	UseCPEs  bool
 }
 
 func NewPackageMatcher(cfg MatcherConfig) *Matcher {
 	return &Matcher{
 		UsePURLs: cfg.UsePURLs,
+		UseCPEs:  cfg.UseCPEs,
 	}
 }
 
@@ -33,6 +38,76 @@ func (m *Matcher) Type() match.MatcherType {
 	return match.PackageMatcher
 }
 
-func (m *Matcher) Match(store eol.Provider, p pkg.Package, eolMatchDate time.Time) (match.Match, error) {
-	return search.ByPackagePURL(store, p, m.Type(), eolMatchDate)
+// Match looks the package up by PURL and falls back to its CPEs when the PURL does not produce a match. When the
+// package itself is not matched to a cycle, its upstream packages (e.g. the source RPM or deb source) are searched
+// instead. A match with an unknown cycle is only returned when no cycle could be found at all.
+func (m *Matcher) Match(store eol.Provider, _ *linux.Release, p pkg.Package, eolMatchDate time.Time) ([]match.Match, error) {
+	pkgMatch, err := m.matchPackage(store, p, eolMatchDate)
+	if err != nil {
+		return nil, err
+	}
+	if hasCycle(pkgMatch) {
+		return []match.Match{pkgMatch}, nil
+	}
+
+	for _, upstream := range pkg.UpstreamPackages(p) {
+		upstreamMatch, err := m.matchPackage(store, upstream, eolMatchDate)
+		if err != nil {
+			// keep what the package itself matched, e.g. a product with an unknown cycle
+			log.Warnf("failed to match upstream package %s of package %s: %v", upstream.Name, p.Name, err)
+			continue
+		}
+		if (upstreamMatch.Cycle == eol.Cycle{}) {
+			continue
+		}
+
+		// report the match against the package that was found, noting the upstream that produced it
+		upstreamMatch.Package = p
+		upstreamMatch.Upstream = &pkg.UpstreamPackage{
+			Name:    upstream.Name,
+			Version: upstream.Version,
+		}
+		if hasCycle(upstreamMatch) || (pkgMatch.Cycle == eol.Cycle{}) {
+			pkgMatch = upstreamMatch
+		}
+		if hasCycle(pkgMatch) {
+			break
+		}
+	}
+
+	if (pkgMatch.Cycle == eol.Cycle{}) {
+		return nil, nil
+	}
+	return []match.Match{pkgMatch}, nil
+}
+
+func (m *Matcher) matchPackage(store eol.Provider, p pkg.Package, eolMatchDate time.Time) (match.Match, error) {
+	var pkgMatch match.Match
+	if m.UsePURLs && p.PURL != "" {
+		var err error
+		pkgMatch, err = search.ByPackagePURL(store, p, m.Type(), eolMatchDate)
+		if err != nil {
+			return match.Match{}, err
+		}
+		if hasCycle(pkgMatch) {
+			return pkgMatch, nil
+		}
+	}
+
+	if m.UseCPEs && len(p.CPEs) > 0 {
+		cpeMatch, err := search.ByPackageCPE(store, p, m.Type(), eolMatchDate)
+		if err != nil {
+			return match.Match{}, err
+		}
+		if hasCycle(cpeMatch) || (pkgMatch.Cycle == eol.Cycle{}) {
+			return cpeMatch, nil
+		}
+	}
+
+	return pkgMatch, nil
+}
+
+// hasCycle reports whether the match is for a known cycle, rather than for a product with an unknown cycle
+func hasCycle(m match.Match) bool {
+	return m.Cycle.ReleaseCycle != ""
 }
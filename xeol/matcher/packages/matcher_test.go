@@ -1,9 +1,11 @@
 package packages
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/anchore/syft/syft/cpe"
 	syftPkg "github.com/anchore/syft/syft/pkg"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -16,6 +18,7 @@ import (
 	"github.com/xeol-io/xeol/xeol/eol"
 	"github.com/xeol-io/xeol/xeol/match"
 	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/search"
 )
 
 type mockStore struct {
@@ -26,6 +29,16 @@ func (s *mockStore) GetCyclesByPurl(purl string) ([]xeolDB.Cycle, error) {
 	return s.backend[purl], nil
 }
 
+func (s *mockStore) GetCyclesByPurls(purls []string) (map[string][]xeolDB.Cycle, error) {
+	cycles := make(map[string][]xeolDB.Cycle)
+	for _, purl := range purls {
+		if c, ok := s.backend[purl]; ok {
+			cycles[purl] = c
+		}
+	}
+	return cycles, nil
+}
+
 func (s *mockStore) GetCyclesByCpe(cpe string) ([]xeolDB.Cycle, error) {
 	return s.backend[cpe], nil
 }
@@ -34,6 +47,10 @@ func (s *mockStore) GetVulnCountByPurlAndVersion(purl string, version string) (i
 	return 0, nil
 }
 
+func (s *mockStore) GetVulnCountsByPurls(_ []string) (map[string]map[string]int, error) {
+	return nil, nil
+}
+
 func (s *mockStore) GetAllProducts() (*[]xeolDB.Product, error) {
 	return nil, nil
 }
@@ -56,7 +73,8 @@ func TestMatch(t *testing.T) {
 	provider, err := db.NewEolProvider(&store)
 	require.NoError(t, err)
 
-	m := Matcher{}
+	now := time.Now()
+	m := Matcher{UsePURLs: true}
 	p := pkg.Package{
 		ID:      pkg.ID(uuid.NewString()),
 		Name:    "mongodb-org-server",
@@ -70,10 +88,26 @@ func TestMatch(t *testing.T) {
 	expected := match.Match{
 		Cycle:   *cycleFound,
 		Package: p,
+		Method:  match.PURLMethod,
+		Details: match.Details{{
+			Type:    match.PURLMethod,
+			Matcher: match.PackageMatcher,
+			SearchedBy: search.Parameters{
+				Query:             "pkg:deb/debian/mongodb-org-server",
+				Version:           "3.2.21",
+				NormalizedVersion: "3.2.21",
+				MatchDate:         now.Format(eol.DateLayout),
+			},
+			Found: search.Result{
+				CandidateCycles: []string{"3.2"},
+				Cycle:           "3.2",
+				ProductName:     "MongoDB Server",
+			},
+		}},
 	}
-	actual, err := m.Match(provider, p, time.Now())
+	actual, err := m.Match(provider, nil, p, now)
 	assert.NoError(t, err)
-	assertMatches(t, expected, actual)
+	assertMatches(t, []match.Match{expected}, actual)
 }
 
 func TestMatchPurlMismatch(t *testing.T) {
@@ -92,7 +126,7 @@ func TestMatchPurlMismatch(t *testing.T) {
 	provider, err := db.NewEolProvider(&store)
 	require.NoError(t, err)
 
-	m := Matcher{}
+	m := Matcher{UsePURLs: true}
 	p := pkg.Package{
 		ID:      pkg.ID(uuid.NewString()),
 		Name:    "mongodb-org-server",
@@ -101,9 +135,9 @@ func TestMatchPurlMismatch(t *testing.T) {
 		PURL:    "pkg:deb/debian/mongodb-org-server@3.2.21?arch=amd64&upstream=mongodb-org&distro=debian-8",
 	}
 
-	actual, err := m.Match(provider, p, time.Now())
+	actual, err := m.Match(provider, nil, p, time.Now())
 	assert.NoError(t, err)
-	assertMatches(t, match.Match{}, actual)
+	assertMatches(t, nil, actual)
 }
 
 func TestMatchNoMatchingVersion(t *testing.T) {
@@ -125,7 +159,7 @@ func TestMatchNoMatchingVersion(t *testing.T) {
 	require.NoError(t, err)
 
 	// Set up a matcher and a package with the same PURL but a different version
-	m := Matcher{}
+	m := Matcher{UsePURLs: true}
 	p := pkg.Package{
 		ID:      pkg.ID(uuid.NewString()),
 		Name:    "mongodb-org-server",
@@ -134,9 +168,12 @@ func TestMatchNoMatchingVersion(t *testing.T) {
 		PURL:    "pkg:deb/debian/mongodb-org-server@3.2.21?arch=amd64&upstream=mongodb-org&distro=debian-8",
 	}
 
-	actual, err := m.Match(provider, p, time.Now())
+	// the product is known, but the version does not match any of its cycles
+	actual, err := m.Match(provider, nil, p, time.Now())
 	assert.NoError(t, err)
-	assertMatches(t, match.Match{}, actual)
+	require.Len(t, actual, 1)
+	assert.Equal(t, match.StatusUnknownCycle, actual[0].Status)
+	assert.Equal(t, eol.Cycle{ProductName: "MongoDB Server"}, actual[0].Cycle)
 }
 
 func TestMatchTimeChange(t *testing.T) {
@@ -157,7 +194,7 @@ func TestMatchTimeChange(t *testing.T) {
 	provider, err := db.NewEolProvider(&store)
 	require.NoError(t, err)
 
-	m := Matcher{}
+	m := Matcher{UsePURLs: true}
 	p := pkg.Package{
 		ID:      pkg.ID(uuid.NewString()),
 		Name:    "mongodb-org-server",
@@ -169,12 +206,266 @@ func TestMatchTimeChange(t *testing.T) {
 	eolMatchTime, err := time.Parse("2006-01-02", "2018-01-01")
 	assert.NoError(t, err)
 
-	actual, err := m.Match(provider, p, eolMatchTime)
+	// the cycle is matched regardless of the match date, it is only classified as supported as of that date
+	actual, err := m.Match(provider, nil, p, eolMatchTime)
+	assert.NoError(t, err)
+	require.Len(t, actual, 1)
+	status, _ := match.StatusConfig{Now: eolMatchTime}.Classify(actual[0].Cycle)
+	assert.Equal(t, match.StatusSupported, status)
+}
+
+func TestMatchWithoutPURLs(t *testing.T) {
+	cycle := xeolDB.Cycle{
+		ProductName:       "MongoDB Server",
+		ReleaseDate:       "2018-07-31",
+		ReleaseCycle:      "3.2",
+		Eol:               "2018-07-31",
+		LatestReleaseDate: "2018-07-31",
+	}
+
+	store := mockStore{
+		backend: map[string][]xeolDB.Cycle{
+			"pkg:deb/debian/mongodb-org-server": {cycle},
+		},
+	}
+
+	provider, err := db.NewEolProvider(&store)
+	require.NoError(t, err)
+
+	// matching by PURL is disabled in the configuration
+	m := NewPackageMatcher(MatcherConfig{UsePURLs: false})
+	p := pkg.Package{
+		ID:      pkg.ID(uuid.NewString()),
+		Name:    "mongodb-org-server",
+		Version: "3.2.21",
+		Type:    syftPkg.DebPkg,
+		PURL:    "pkg:deb/debian/mongodb-org-server@3.2.21?arch=amd64&upstream=mongodb-org&distro=debian-8",
+	}
+
+	actual, err := m.Match(provider, nil, p, time.Now())
 	assert.NoError(t, err)
-	assertMatches(t, match.Match{}, actual)
+	assertMatches(t, nil, actual)
+}
+
+func TestMatchCPEFallback(t *testing.T) {
+	cycle := xeolDB.Cycle{
+		ProductName:       "Redis",
+		ReleaseDate:       "2020-05-02",
+		ReleaseCycle:      "6.0",
+		Eol:               "2023-08-01",
+		LatestReleaseDate: "2023-07-10",
+	}
+
+	store := mockStore{
+		backend: map[string][]xeolDB.Cycle{
+			"cpe:2.3:a:redis:redis": {cycle},
+		},
+	}
+
+	provider, err := db.NewEolProvider(&store)
+	require.NoError(t, err)
+
+	cycleFound, err := eol.NewCycle(cycle)
+	require.NoError(t, err)
+
+	now := time.Now()
+	tests := []struct {
+		name     string
+		config   MatcherConfig
+		pkg      pkg.Package
+		expected bool
+	}{
+		{
+			name:   "purl is not known",
+			config: MatcherConfig{UsePURLs: true, UseCPEs: true},
+			pkg: pkg.Package{
+				Name:    "redis",
+				Version: "6.0.16",
+				Type:    syftPkg.BinaryPkg,
+				PURL:    "pkg:generic/redis@6.0.16",
+				CPEs:    []cpe.CPE{cpe.Must("cpe:2.3:a:redis:redis:6.0.16:*:*:*:*:*:*:*", "")},
+			},
+			expected: true,
+		},
+		{
+			name:   "package without purl",
+			config: MatcherConfig{UsePURLs: true, UseCPEs: true},
+			pkg: pkg.Package{
+				Name:    "redis",
+				Version: "6.0.16",
+				Type:    syftPkg.BinaryPkg,
+				CPEs:    []cpe.CPE{cpe.Must("cpe:2.3:a:redis:redis:6.0.16:*:*:*:*:*:*:*", "")},
+			},
+			expected: true,
+		},
+		{
+			name:   "cpes disabled",
+			config: MatcherConfig{UsePURLs: true, UseCPEs: false},
+			pkg: pkg.Package{
+				Name:    "redis",
+				Version: "6.0.16",
+				Type:    syftPkg.BinaryPkg,
+				PURL:    "pkg:generic/redis@6.0.16",
+				CPEs:    []cpe.CPE{cpe.Must("cpe:2.3:a:redis:redis:6.0.16:*:*:*:*:*:*:*", "")},
+			},
+			expected: false,
+		},
+		{
+			name:   "cpe is not known",
+			config: MatcherConfig{UsePURLs: true, UseCPEs: true},
+			pkg: pkg.Package{
+				Name:    "redis",
+				Version: "6.0.16",
+				Type:    syftPkg.BinaryPkg,
+				PURL:    "pkg:generic/redis@6.0.16",
+				CPEs:    []cpe.CPE{cpe.Must("cpe:2.3:a:other:redis:6.0.16:*:*:*:*:*:*:*", "")},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewPackageMatcher(tt.config)
+			actual, err := m.Match(provider, nil, tt.pkg, now)
+			require.NoError(t, err)
+
+			var expected []match.Match
+			if tt.expected {
+				expected = []match.Match{{
+					Cycle:   *cycleFound,
+					Package: tt.pkg,
+					Method:  match.CPEMethod,
+					Details: match.Details{{
+						Type:    match.CPEMethod,
+						Matcher: match.PackageMatcher,
+						SearchedBy: search.Parameters{
+							Query:             "cpe:2.3:a:redis:redis",
+							Version:           "6.0.16",
+							NormalizedVersion: "6.0.16",
+							MatchDate:         now.Format(eol.DateLayout),
+						},
+						Found: search.Result{
+							CandidateCycles: []string{"6.0"},
+							Cycle:           "6.0",
+							ProductName:     "Redis",
+						},
+					}},
+				}}
+			}
+			assertMatches(t, expected, actual)
+		})
+	}
+}
+
+func TestMatchUpstream(t *testing.T) {
+	cycle := xeolDB.Cycle{
+		ProductName:       "Python",
+		ReleaseDate:       "2016-12-23",
+		ReleaseCycle:      "3.6",
+		Eol:               "2021-12-23",
+		LatestReleaseDate: "2021-09-04",
+	}
+
+	store := mockStore{
+		backend: map[string][]xeolDB.Cycle{
+			"pkg:deb/ubuntu/python3.6": {cycle},
+		},
+	}
+
+	provider, err := db.NewEolProvider(&store)
+	require.NoError(t, err)
+
+	cycleFound, err := eol.NewCycle(cycle)
+	require.NoError(t, err)
+
+	now := time.Now()
+	m := NewPackageMatcher(MatcherConfig{UsePURLs: true, UseCPEs: true})
+	p := pkg.Package{
+		ID:      pkg.ID(uuid.NewString()),
+		Name:    "libpython3.6",
+		Version: "3.6.9-1~18.04ubuntu1.13",
+		Type:    syftPkg.DebPkg,
+		PURL:    "pkg:deb/ubuntu/libpython3.6@3.6.9-1~18.04ubuntu1.13?arch=amd64&upstream=python3.6&distro=ubuntu-18.04",
+		Upstreams: []pkg.UpstreamPackage{
+			{Name: "python3.6", Version: "3.6.9"},
+		},
+	}
+
+	expected := match.Match{
+		Cycle:    *cycleFound,
+		Package:  p,
+		Method:   match.PURLMethod,
+		Upstream: &pkg.UpstreamPackage{Name: "python3.6", Version: "3.6.9"},
+		Details: match.Details{{
+			Type:    match.PURLMethod,
+			Matcher: match.PackageMatcher,
+			SearchedBy: search.Parameters{
+				Query:             "pkg:deb/ubuntu/python3.6",
+				Version:           "3.6.9",
+				NormalizedVersion: "3.6.9",
+				MatchDate:         now.Format(eol.DateLayout),
+			},
+			Found: search.Result{
+				CandidateCycles: []string{"3.6"},
+				Cycle:           "3.6",
+				ProductName:     "Python",
+			},
+		}},
+	}
+	actual, err := m.Match(provider, nil, p, now)
+	require.NoError(t, err)
+	assertMatches(t, []match.Match{expected}, actual)
+}
+
+// failingStore fails the lookups of a single PURL
+type failingStore struct {
+	mockStore
+	purl string
+}
+
+func (s *failingStore) GetCyclesByPurl(purl string) ([]xeolDB.Cycle, error) {
+	if purl == s.purl {
+		return nil, errors.New("lookup failed")
+	}
+	return s.mockStore.GetCyclesByPurl(purl)
+}
+
+func TestMatchUpstreamLookupFails(t *testing.T) {
+	store := failingStore{
+		mockStore: mockStore{
+			backend: map[string][]xeolDB.Cycle{
+				"pkg:deb/ubuntu/libpython3.6": {{ProductName: "Python", ReleaseCycle: "2.7", Eol: "2020-01-01"}},
+			},
+		},
+		purl: "pkg:deb/ubuntu/python3.6",
+	}
+
+	provider, err := db.NewEolProvider(&store)
+	require.NoError(t, err)
+
+	m := NewPackageMatcher(MatcherConfig{UsePURLs: true})
+	p := pkg.Package{
+		ID:      pkg.ID(uuid.NewString()),
+		Name:    "libpython3.6",
+		Version: "3.6.9-1~18.04ubuntu1.13",
+		Type:    syftPkg.DebPkg,
+		PURL:    "pkg:deb/ubuntu/libpython3.6@3.6.9-1~18.04ubuntu1.13?arch=amd64&upstream=python3.6&distro=ubuntu-18.04",
+		Upstreams: []pkg.UpstreamPackage{
+			{Name: "python3.6", Version: "3.6.9"},
+		},
+	}
+
+	// the unknown cycle match of the package itself is kept
+	actual, err := m.Match(provider, nil, p, time.Now())
+	require.NoError(t, err)
+	require.Len(t, actual, 1)
+	assert.Equal(t, match.StatusUnknownCycle, actual[0].Status)
+	assert.Equal(t, "Python", actual[0].Cycle.ProductName)
+	assert.Nil(t, actual[0].Upstream)
 }
 
-func assertMatches(t *testing.T, expected, actual match.Match) {
+func assertMatches(t *testing.T, expected, actual []match.Match) {
 	t.Helper()
 	var opts = []cmp.Option{
 		cmpopts.IgnoreFields(pkg.Package{}, "Locations"),
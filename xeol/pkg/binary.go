@@ -0,0 +1,46 @@
+package pkg
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// binaryProducts maps the PURLs (without version) of runtimes found by the syft binary classifiers to the PURLs
+// known to the EOL database, for classifiers whose PURL does not already match. Binaries found by the other
+// classifiers (e.g. pkg:generic/python, pkg:generic/node, pkg:generic/go) are matched by their PURL as-is. See
+// TestBinaryClassifierPURLs for the PURL every classifier is matched by.
+var binaryProducts = map[string]string{
+	"pkg:generic/java/jre":         "pkg:generic/java",
+	"pkg:generic/java/jdk":         "pkg:generic/java",
+	"pkg:generic/php-cli":          "pkg:generic/php",
+	"pkg:generic/php-fpm":          "pkg:generic/php",
+	"pkg:github/fluent/fluent-bit": "pkg:generic/fluent-bit",
+}
+
+// LookupPURL returns the PURL the EOL database is searched by for the package. The PURL of a package found by the
+// binary classifiers is returned in the form known to the EOL database, e.g. pkg:generic/java@17.0.9 for
+// pkg:generic/java/jre@17.0.9, the PURL of any other package is returned unchanged. The package keeps the PURL
+// it was reported with.
+func LookupPURL(p Package) string {
+	if p.Type != pkg.BinaryPkg || p.PURL == "" {
+		return p.PURL
+	}
+
+	purl, err := packageurl.FromString(p.PURL)
+	if err != nil {
+		return p.PURL
+	}
+	short := packageurl.NewPackageURL(purl.Type, purl.Namespace, purl.Name, "", nil, "")
+
+	product, ok := binaryProducts[short.ToString()]
+	if !ok {
+		return p.PURL
+	}
+	mapped, err := packageurl.FromString(product)
+	if err != nil {
+		return p.PURL
+	}
+	mapped.Version = purl.Version
+	mapped.Qualifiers = purl.Qualifiers
+	return mapped.ToString()
+}
@@ -0,0 +1,121 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/binary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupPURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		pkg      pkg.Package
+		expected string
+	}{
+		{
+			name:     "openjdk runtime is mapped to java",
+			pkg:      pkg.Package{Name: "java/jre", Version: "1.8.0_352-b08", Type: pkg.BinaryPkg, PURL: "pkg:generic/java/jre@1.8.0_352-b08"},
+			expected: "pkg:generic/java@1.8.0_352-b08",
+		},
+		{
+			name:     "jdk is mapped to java",
+			pkg:      pkg.Package{Name: "java/jdk", Version: "17.0.9+9", Type: pkg.BinaryPkg, PURL: "pkg:generic/java/jdk@17.0.9+9"},
+			expected: "pkg:generic/java@17.0.9%2B9",
+		},
+		{
+			name:     "php cli is mapped to php",
+			pkg:      pkg.Package{Name: "php-cli", Version: "8.1.2", Type: pkg.BinaryPkg, PURL: "pkg:generic/php-cli@8.1.2"},
+			expected: "pkg:generic/php@8.1.2",
+		},
+		{
+			name:     "runtimes known to the EOL database are unchanged",
+			pkg:      pkg.Package{Name: "python", Version: "3.7.17", Type: pkg.BinaryPkg, PURL: "pkg:generic/python@3.7.17"},
+			expected: "pkg:generic/python@3.7.17",
+		},
+		{
+			name:     "non binary packages are unchanged",
+			pkg:      pkg.Package{Name: "java/jre", Version: "1.0.0", Type: pkg.JavaPkg, PURL: "pkg:generic/java/jre@1.0.0"},
+			expected: "pkg:generic/java/jre@1.0.0",
+		},
+		{
+			name:     "invalid purls are unchanged",
+			pkg:      pkg.Package{Name: "java/jre", Version: "1.0.0", Type: pkg.BinaryPkg, PURL: "java/jre"},
+			expected: "java/jre",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := New(test.pkg)
+			assert.Equal(t, test.expected, LookupPURL(p))
+			// the package keeps the PURL it was reported with
+			assert.Equal(t, test.pkg.PURL, p.PURL)
+		})
+	}
+}
+
+// TestBinaryClassifierPURLs checks the PURL every binary classifier enabled for scans results in, so that a
+// classifier added to syft (or a changed classifier PURL) has to be mapped to the PURL of its product in the EOL
+// database, or confirmed to match as-is.
+func TestBinaryClassifierPURLs(t *testing.T) {
+	// the PURLs (without version) of the classifiers and the PURLs the EOL database is searched by
+	expected := map[string]string{
+		"pkg:generic/python":                     "pkg:generic/python",
+		"pkg:generic/pypy":                       "pkg:generic/pypy",
+		"pkg:generic/go":                         "pkg:generic/go",
+		"pkg:generic/julia":                      "pkg:generic/julia",
+		"pkg:golang/helm.sh/helm":                "pkg:golang/helm.sh/helm",
+		"pkg:generic/redis":                      "pkg:generic/redis",
+		"pkg:generic/java/jre":                   "pkg:generic/java",
+		"pkg:generic/java/jdk":                   "pkg:generic/java",
+		"pkg:generic/java/graalvm":               "pkg:generic/java/graalvm",
+		"pkg:generic/node":                       "pkg:generic/node",
+		"pkg:generic/busybox":                    "pkg:generic/busybox",
+		"pkg:generic/util-linux":                 "pkg:generic/util-linux",
+		"pkg:generic/haproxy":                    "pkg:generic/haproxy",
+		"pkg:generic/perl":                       "pkg:generic/perl",
+		"pkg:generic/php-cli":                    "pkg:generic/php",
+		"pkg:generic/php-fpm":                    "pkg:generic/php",
+		"pkg:generic/php":                        "pkg:generic/php",
+		"pkg:generic/composer":                   "pkg:generic/composer",
+		"pkg:generic/httpd":                      "pkg:generic/httpd",
+		"pkg:generic/memcached":                  "pkg:generic/memcached",
+		"pkg:generic/traefik":                    "pkg:generic/traefik",
+		"pkg:generic/arangodb":                   "pkg:generic/arangodb",
+		"pkg:generic/postgresql":                 "pkg:generic/postgresql",
+		"pkg:generic/mysql":                      "pkg:generic/mysql",
+		"pkg:generic/percona-server":             "pkg:generic/percona-server",
+		"pkg:generic/percona-xtradb-cluster":     "pkg:generic/percona-xtradb-cluster",
+		"pkg:generic/percona-xtrabackup":         "pkg:generic/percona-xtrabackup",
+		"pkg:generic/mariadb":                    "pkg:generic/mariadb",
+		"pkg:generic/rust":                       "pkg:generic/rust",
+		"pkg:generic/ruby":                       "pkg:generic/ruby",
+		"pkg:generic/erlang":                     "pkg:generic/erlang",
+		"pkg:golang/github.com/hashicorp/consul": "pkg:golang/github.com/hashicorp/consul",
+		"pkg:generic/nginx":                      "pkg:generic/nginx",
+		"pkg:generic/bash":                       "pkg:generic/bash",
+		"pkg:generic/openssl":                    "pkg:generic/openssl",
+		"pkg:generic/gcc":                        "pkg:generic/gcc",
+		"pkg:github/fluent/fluent-bit":           "pkg:generic/fluent-bit",
+		"pkg:generic/wp-cli":                     "pkg:generic/wp-cli",
+	}
+
+	for _, classifier := range binary.DefaultClassifiers() {
+		t.Run(classifier.Class+"/"+classifier.Package, func(t *testing.T) {
+			// the binary cataloger sets the version found on the classifier PURL
+			purl := classifier.PURL
+			purl.Version = "1.2.3"
+			p := pkg.Package{Name: classifier.Package, Version: purl.Version, Type: pkg.BinaryPkg, PURL: purl.ToString()}
+
+			short := packageurl.NewPackageURL(classifier.PURL.Type, classifier.PURL.Namespace, classifier.PURL.Name, "", nil, "")
+			product, ok := expected[short.ToString()]
+			require.True(t, ok, "no expected PURL for the %q classifier PURL %s", classifier.Class, short.ToString())
+
+			assert.Equal(t, product+"@1.2.3", LookupPURL(New(p)))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package pkg
 import (
 	"strings"
 
+	"github.com/anchore/packageurl-go"
 	"github.com/anchore/syft/syft/cpe"
 	"github.com/scylladb/go-set/strset"
 )
@@ -26,6 +27,7 @@ func UpstreamPackages(p Package) (pkgs []Package) {
 			tmp.Version = u.Version
 		}
 		tmp.Upstreams = nil
+		tmp.PURL = upstreamPURL(p.PURL, u)
 
 		// for each cpe, replace pkg name with origin and add to set
 		cpeStrings := strset.New()
@@ -51,3 +53,23 @@ func UpstreamPackages(p Package) (pkgs []Package) {
 	}
 	return pkgs
 }
+
+// upstreamPURL rewrites the name (and version, if known) of a package PURL to point at the upstream package,
+// keeping the qualifiers of the original PURL. An empty string is returned when the original PURL cannot be parsed.
+func upstreamPURL(original string, u UpstreamPackage) string {
+	if original == "" {
+		return ""
+	}
+
+	p, err := packageurl.FromString(original)
+	if err != nil {
+		return ""
+	}
+
+	p.Name = u.Name
+	if u.Version != "" {
+		p.Version = u.Version
+	}
+
+	return p.ToString()
+}
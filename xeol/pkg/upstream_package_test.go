@@ -74,6 +74,28 @@ func TestUpstreamPackages(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with upstream name and version and a purl",
+			pkg: Package{
+				Name:    "libpython3.6",
+				Version: "3.6.9-1~18.04ubuntu1.13",
+				PURL:    "pkg:deb/ubuntu/libpython3.6@3.6.9-1~18.04ubuntu1.13?arch=amd64&upstream=python3.6&distro=ubuntu-18.04",
+				Upstreams: []UpstreamPackage{
+					{
+						Name:    "python3.6",
+						Version: "3.6.9",
+					},
+				},
+			},
+			expected: []Package{
+				{
+					Name:    "python3.6", // new
+					Version: "3.6.9",     // new
+					// name and version replaced, qualifiers kept
+					PURL: "pkg:deb/ubuntu/python3.6@3.6.9?arch=amd64&upstream=python3.6&distro=ubuntu-18.04",
+				},
+			},
+		},
 		{
 			name: "no upstream name results in no package",
 			pkg: Package{
@@ -15,8 +15,6 @@ import (
 	"github.com/xeol-io/xeol/xeol/policy/types"
 )
 
-var timeNow = time.Now
-
 const (
 	DateLayout                                 = "2006-01-02"
 	CycleOperatorLessThan        CycleOperator = "LT"
@@ -94,12 +92,13 @@ func (e PolicyWrapper) GetPolicyType() types.PolicyType {
 	return e.PolicyType
 }
 
-func (e PolicyWrapper) Evaluate(matches match.Matches, projectName string, _ string, _ string) (bool, types.PolicyEvaluationResult) {
-	policyMatches := evaluateMatches(e.Policies, matches, projectName)
+func (e PolicyWrapper) Evaluate(matches match.Matches, evalCtx types.EvaluationContext) (bool, []types.PolicyEvaluationResult) {
+	policyMatches := evaluateMatches(e.Policies, matches, evalCtx.ProjectName, evalCtx.Now())
 
 	// whether we should fail the scan or not
 	failScan := false
 
+	results := make([]types.PolicyEvaluationResult, 0, len(policyMatches))
 	for _, policyMatch := range policyMatches {
 		if policyMatch.Action == types.PolicyActionDeny {
 			failScan = true
@@ -108,12 +107,13 @@ func (e PolicyWrapper) Evaluate(matches match.Matches, projectName string, _ str
 			Type:  event.EolPolicyEvaluationMessage,
 			Value: policyMatch,
 		})
+		results = append(results, policyMatch)
 	}
 
-	return failScan, types.EolEvaluationResult{}
+	return failScan, results
 }
 
-func evaluateMatches(policies []Policy, matches match.Matches, projectName string) []types.EolEvaluationResult {
+func evaluateMatches(policies []Policy, matches match.Matches, projectName string, now time.Time) []types.EolEvaluationResult {
 	var results []types.EolEvaluationResult
 
 	// keep track of which matches have been evaluated
@@ -154,12 +154,12 @@ func evaluateMatches(policies []Policy, matches match.Matches, projectName strin
 			}
 
 			// deny policy takes precedence over warn policy, so order is important here
-			if denyMatch(&policyCopy, match) {
+			if denyMatch(&policyCopy, match, now) {
 				results = append(results, createEolEvaluationResult(policyCopy, match, types.PolicyActionDeny))
 				evaluatedMatches[match.Cycle.ProductName] = true
 				continue
 			}
-			if warnMatch(&policyCopy, match) {
+			if warnMatch(&policyCopy, match, now) {
 				results = append(results, createEolEvaluationResult(policyCopy, match, types.PolicyActionWarn))
 				evaluatedMatches[match.Cycle.ProductName] = true
 				continue
@@ -199,7 +199,7 @@ func cycleOperatorMatch(m match.Match, policy Policy) bool {
 	}
 }
 
-func warnMatch(policy *Policy, match match.Match) bool {
+func warnMatch(policy *Policy, match match.Match, now time.Time) bool {
 	var warnDate time.Time
 
 	if policy.WarnDate != "" {
@@ -229,14 +229,14 @@ func warnMatch(policy *Policy, match match.Match) bool {
 		return false
 	}
 
-	if timeNow().After(warnDate) {
+	if now.After(warnDate) {
 		return true
 	}
 
 	return false
 }
 
-func denyMatch(policy *Policy, match match.Match) bool {
+func denyMatch(policy *Policy, match match.Match, now time.Time) bool {
 	var denyDate time.Time
 
 	if policy.DenyDate != "" {
@@ -268,7 +268,7 @@ func denyMatch(policy *Policy, match match.Match) bool {
 		return false
 	}
 
-	if timeNow().After(denyDate) {
+	if now.After(denyDate) {
 		return true
 	}
 
@@ -277,12 +277,18 @@ func denyMatch(policy *Policy, match match.Match) bool {
 
 func createEolEvaluationResult(policy Policy, match match.Match, policyAction types.PolicyAction) types.EolEvaluationResult {
 	result := types.EolEvaluationResult{
-		Action:      policyAction,
-		Type:        types.PolicyTypeEol,
-		ProductName: match.Cycle.ProductName,
-		Cycle:       match.Cycle.ReleaseCycle,
+		Action:         policyAction,
+		Type:           types.PolicyTypeEol,
+		ProductName:    match.Cycle.ProductName,
+		Cycle:          match.Cycle.ReleaseCycle,
+		PackageID:      string(match.Package.ID),
+		PackageName:    match.Package.Name,
+		PackageVersion: match.Package.Version,
+		PURL:           match.Package.PURL,
 	}
 	if policy != (Policy{}) {
+		result.PolicyID = policy.ID
+		result.PolicyScope = string(policy.PolicyScope)
 		if policyAction == types.PolicyActionWarn {
 			result.FailDate = policy.DenyDate
 		}
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	syftPkg "github.com/anchore/syft/syft/pkg"
-	"github.com/google/uuid"
 
 	"github.com/xeol-io/xeol/xeol/eol"
 	"github.com/xeol-io/xeol/xeol/match"
@@ -46,7 +45,7 @@ func TestEvaluate(t *testing.T) {
 						EolBool:      true,
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("package-e@2.0.0"),
 						Name:    "package-e",
 						Version: "2.0.0",
 						Type:    syftPkg.RpmPkg,
@@ -55,10 +54,13 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: []types.EolEvaluationResult{
 				{
-					Action:      types.PolicyActionWarn, // eol bool is always a warn
-					Type:        types.PolicyTypeEol,
-					ProductName: "foo",
-					Cycle:       "1.0.0",
+					Action:         types.PolicyActionWarn, // eol bool is always a warn
+					Type:           types.PolicyTypeEol,
+					ProductName:    "foo",
+					Cycle:          "1.0.0",
+					PackageID:      "package-e@2.0.0",
+					PackageName:    "package-e",
+					PackageVersion: "2.0.0",
 					// fail date should be empty for eol bool
 				},
 			},
@@ -82,7 +84,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.0.0",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("package-e@2.0.0"),
 						Name:    "package-e",
 						Version: "2.0.0",
 						Type:    syftPkg.RpmPkg,
@@ -95,6 +97,7 @@ func TestEvaluate(t *testing.T) {
 			name: "policy with deny match",
 			policy: []Policy{
 				{
+					ID:            "2b1b5bd4-6c3e-4e3a-9e0f-1b7f0f3c2a11",
 					ProductName:   "foo",
 					Cycle:         "1.0",
 					PolicyScope:   PolicyScopeSoftware,
@@ -110,7 +113,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.0",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.2.1"),
 						Name:    "foo",
 						Version: "1.2.1",
 						Type:    syftPkg.RpmPkg,
@@ -119,10 +122,15 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: []types.EolEvaluationResult{
 				{
-					Action:      types.PolicyActionDeny,
-					Type:        types.PolicyTypeEol,
-					ProductName: "foo",
-					Cycle:       "1.0",
+					Action:         types.PolicyActionDeny,
+					Type:           types.PolicyTypeEol,
+					PolicyID:       "2b1b5bd4-6c3e-4e3a-9e0f-1b7f0f3c2a11",
+					PolicyScope:    string(PolicyScopeSoftware),
+					ProductName:    "foo",
+					Cycle:          "1.0",
+					PackageID:      "foo@1.2.1",
+					PackageName:    "foo",
+					PackageVersion: "1.2.1",
 				},
 			},
 		},
@@ -145,7 +153,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.0",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.2.1"),
 						Name:    "foo",
 						Version: "1.2.1",
 						Type:    syftPkg.RpmPkg,
@@ -154,11 +162,15 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: []types.EolEvaluationResult{
 				{
-					Action:      types.PolicyActionWarn,
-					Type:        types.PolicyTypeEol,
-					ProductName: "foo",
-					Cycle:       "1.0",
-					FailDate:    "2021-03-01",
+					Action:         types.PolicyActionWarn,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeSoftware),
+					ProductName:    "foo",
+					Cycle:          "1.0",
+					PackageID:      "foo@1.2.1",
+					PackageName:    "foo",
+					PackageVersion: "1.2.1",
+					FailDate:       "2021-03-01",
 				},
 			},
 		},
@@ -181,7 +193,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "0.9",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@0.9.0"),
 						Name:    "foo",
 						Version: "0.9.0",
 						Type:    syftPkg.RpmPkg,
@@ -190,11 +202,15 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: []types.EolEvaluationResult{
 				{
-					Action:      types.PolicyActionWarn,
-					Type:        types.PolicyTypeEol,
-					ProductName: "foo",
-					Cycle:       "0.9",
-					FailDate:    "2021-03-01",
+					Action:         types.PolicyActionWarn,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeSoftware),
+					ProductName:    "foo",
+					Cycle:          "0.9",
+					PackageID:      "foo@0.9.0",
+					PackageName:    "foo",
+					PackageVersion: "0.9.0",
+					FailDate:       "2021-03-01",
 				},
 			},
 		},
@@ -217,7 +233,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.0",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.0.1"),
 						Name:    "foo",
 						Version: "1.0.1",
 						Type:    syftPkg.RpmPkg,
@@ -226,11 +242,15 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: []types.EolEvaluationResult{
 				{
-					Action:      types.PolicyActionWarn,
-					Type:        types.PolicyTypeEol,
-					ProductName: "foo",
-					Cycle:       "1.0",
-					FailDate:    "2021-03-01",
+					Action:         types.PolicyActionWarn,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeSoftware),
+					ProductName:    "foo",
+					Cycle:          "1.0",
+					PackageID:      "foo@1.0.1",
+					PackageName:    "foo",
+					PackageVersion: "1.0.1",
+					FailDate:       "2021-03-01",
 				},
 			},
 		},
@@ -261,7 +281,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.3",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.3.0"),
 						Name:    "foo",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -273,7 +293,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "2.0",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("bar@2.0.1"),
 						Name:    "bar",
 						Version: "2.0.1",
 						Type:    syftPkg.RpmPkg,
@@ -282,17 +302,25 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: []types.EolEvaluationResult{
 				{
-					Action:      types.PolicyActionDeny,
-					Type:        types.PolicyTypeEol,
-					ProductName: "bar",
-					Cycle:       "2.0",
+					Action:         types.PolicyActionDeny,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeSoftware),
+					ProductName:    "bar",
+					Cycle:          "2.0",
+					PackageID:      "bar@2.0.1",
+					PackageName:    "bar",
+					PackageVersion: "2.0.1",
 				},
 				{
-					Action:      types.PolicyActionWarn,
-					Type:        types.PolicyTypeEol,
-					ProductName: "foo",
-					Cycle:       "1.3",
-					FailDate:    "2021-03-01",
+					Action:         types.PolicyActionWarn,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeSoftware),
+					ProductName:    "foo",
+					Cycle:          "1.3",
+					PackageID:      "foo@1.3.0",
+					PackageName:    "foo",
+					PackageVersion: "1.3.0",
+					FailDate:       "2021-03-01",
 				},
 			},
 		},
@@ -315,7 +343,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.3",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.3.0"),
 						Name:    "foo",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -343,7 +371,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.3",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.3.0"),
 						Name:    "foo",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -371,7 +399,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.3",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.3.0"),
 						Name:    "foo",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -397,7 +425,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.3",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.3.0"),
 						Name:    "foo",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -409,7 +437,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.3",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("bar@1.3.0"),
 						Name:    "bar",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -418,16 +446,24 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: []types.EolEvaluationResult{
 				{
-					Action:      types.PolicyActionDeny,
-					Type:        types.PolicyTypeEol,
-					ProductName: "bar",
-					Cycle:       "1.3",
+					Action:         types.PolicyActionDeny,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeGlobal),
+					ProductName:    "bar",
+					Cycle:          "1.3",
+					PackageID:      "bar@1.3.0",
+					PackageName:    "bar",
+					PackageVersion: "1.3.0",
 				},
 				{
-					Action:      types.PolicyActionDeny,
-					Type:        types.PolicyTypeEol,
-					ProductName: "foo",
-					Cycle:       "1.3",
+					Action:         types.PolicyActionDeny,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeGlobal),
+					ProductName:    "foo",
+					Cycle:          "1.3",
+					PackageID:      "foo@1.3.0",
+					PackageName:    "foo",
+					PackageVersion: "1.3.0",
 				},
 			},
 		},
@@ -458,7 +494,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.3",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.3.0"),
 						Name:    "foo",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -470,7 +506,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.3",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("bar@1.3.0"),
 						Name:    "bar",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -479,17 +515,25 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: []types.EolEvaluationResult{
 				{
-					Action:      types.PolicyActionWarn,
-					Type:        types.PolicyTypeEol,
-					ProductName: "foo",
-					Cycle:       "1.3",
-					FailDate:    "2021-02-29",
+					Action:         types.PolicyActionWarn,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeSoftware),
+					ProductName:    "foo",
+					Cycle:          "1.3",
+					PackageID:      "foo@1.3.0",
+					PackageName:    "foo",
+					PackageVersion: "1.3.0",
+					FailDate:       "2021-02-29",
 				},
 				{
-					Action:      types.PolicyActionDeny,
-					Type:        types.PolicyTypeEol,
-					ProductName: "bar",
-					Cycle:       "1.3",
+					Action:         types.PolicyActionDeny,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeProject),
+					ProductName:    "bar",
+					Cycle:          "1.3",
+					PackageID:      "bar@1.3.0",
+					PackageName:    "bar",
+					PackageVersion: "1.3.0",
 				},
 			},
 		},
@@ -517,7 +561,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.3",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.3.0"),
 						Name:    "foo",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -529,7 +573,7 @@ func TestEvaluate(t *testing.T) {
 						ReleaseCycle: "1.3",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("bar@1.3.0"),
 						Name:    "bar",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -538,18 +582,26 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: []types.EolEvaluationResult{
 				{
-					Action:      types.PolicyActionWarn,
-					Type:        types.PolicyTypeEol,
-					ProductName: "bar",
-					Cycle:       "1.3",
-					FailDate:    "2021-02-29",
+					Action:         types.PolicyActionWarn,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeProject),
+					ProductName:    "bar",
+					Cycle:          "1.3",
+					PackageID:      "bar@1.3.0",
+					PackageName:    "bar",
+					PackageVersion: "1.3.0",
+					FailDate:       "2021-02-29",
 				},
 				{
-					Action:      types.PolicyActionWarn,
-					Type:        types.PolicyTypeEol,
-					ProductName: "foo",
-					Cycle:       "1.3",
-					FailDate:    "2021-02-29",
+					Action:         types.PolicyActionWarn,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeProject),
+					ProductName:    "foo",
+					Cycle:          "1.3",
+					PackageID:      "foo@1.3.0",
+					PackageName:    "foo",
+					PackageVersion: "1.3.0",
+					FailDate:       "2021-02-29",
 				},
 			},
 		},
@@ -571,7 +623,7 @@ func TestEvaluate(t *testing.T) {
 						Eol:          "2021-02-28",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.3.0"),
 						Name:    "foo",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -580,10 +632,14 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: []types.EolEvaluationResult{
 				{
-					Action:      types.PolicyActionDeny,
-					Type:        types.PolicyTypeEol,
-					ProductName: "foo",
-					Cycle:       "1.3",
+					Action:         types.PolicyActionDeny,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeGlobal),
+					ProductName:    "foo",
+					Cycle:          "1.3",
+					PackageID:      "foo@1.3.0",
+					PackageName:    "foo",
+					PackageVersion: "1.3.0",
 				},
 			},
 		},
@@ -605,7 +661,7 @@ func TestEvaluate(t *testing.T) {
 						Eol:          "2021-03-28",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.3.0"),
 						Name:    "foo",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -614,11 +670,15 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: []types.EolEvaluationResult{
 				{
-					Action:      types.PolicyActionWarn,
-					Type:        types.PolicyTypeEol,
-					ProductName: "foo",
-					Cycle:       "1.3",
-					FailDate:    "2021-02-26",
+					Action:         types.PolicyActionWarn,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeGlobal),
+					ProductName:    "foo",
+					Cycle:          "1.3",
+					PackageID:      "foo@1.3.0",
+					PackageName:    "foo",
+					PackageVersion: "1.3.0",
+					FailDate:       "2021-02-26",
 				},
 			},
 		},
@@ -639,7 +699,7 @@ func TestEvaluate(t *testing.T) {
 						Eol:          "2021-02-28",
 					},
 					Package: pkg.Package{
-						ID:      pkg.ID(uuid.NewString()),
+						ID:      pkg.ID("foo@1.3.0"),
 						Name:    "foo",
 						Version: "1.3.0",
 						Type:    syftPkg.RpmPkg,
@@ -648,10 +708,14 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: []types.EolEvaluationResult{
 				{
-					Action:      types.PolicyActionDeny,
-					Type:        types.PolicyTypeEol,
-					ProductName: "foo",
-					Cycle:       "1.3",
+					Action:         types.PolicyActionDeny,
+					Type:           types.PolicyTypeEol,
+					PolicyScope:    string(PolicyScopeGlobal),
+					ProductName:    "foo",
+					Cycle:          "1.3",
+					PackageID:      "foo@1.3.0",
+					PackageName:    "foo",
+					PackageVersion: "1.3.0",
 				},
 			},
 		},
@@ -659,12 +723,10 @@ func TestEvaluate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			timeNow = func() time.Time {
-				return time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
-			}
+			now := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
 
 			matches := match.NewMatches(tt.matches...)
-			policyMatches := evaluateMatches(tt.policy, matches, "github//foo/bar")
+			policyMatches := evaluateMatches(tt.policy, matches, "github//foo/bar", now)
 			if len(policyMatches) != len(tt.want) {
 				t.Errorf("expected %d policy matches, got %d", len(tt.want), len(policyMatches))
 			}
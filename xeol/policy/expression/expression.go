@@ -0,0 +1,251 @@
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"github.com/wagoodman/go-partybus"
+
+	"github.com/xeol-io/xeol/internal/bus"
+	"github.com/xeol-io/xeol/internal/log"
+	"github.com/xeol-io/xeol/xeol/event"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/policy/types"
+)
+
+type PolicyWrapper struct {
+	PolicyType types.PolicyType `json:"PolicyType"`
+	Policies   []Policy         `json:"Policies"`
+}
+
+// Policy warns or fails xeol scans for the matches its conditions hold for. The conditions are boolean
+// expressions (https://expr-lang.org) over the match, e.g.
+//
+//	package.language == "java" && !cycle.lts && daysEol >= -30 && source.imageLabels.tier == "prod"
+//
+// See env for the variables available to the expressions. The expressions are compiled when the policy is
+// unmarshalled.
+type Policy struct {
+	ID string `json:"ID"`
+	// the expression that, when true for a match, warns xeol scans
+	WarnWhen string `json:"WarnWhen,omitempty"`
+	// the expression that, when true for a match, fails xeol scans. Deny takes precedence over warn.
+	DenyWhen string `json:"DenyWhen,omitempty"`
+
+	// the compiled WarnWhen and DenyWhen expressions, nil when the expression is empty
+	warn *vm.Program
+	deny *vm.Program
+}
+
+// UnmarshalJSON decodes the policy and compiles its expressions, so that an invalid expression fails loading the
+// policy rather than being skipped on every scan
+func (p *Policy) UnmarshalJSON(data []byte) error {
+	type rawPolicy Policy
+	var raw rawPolicy
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*p = Policy(raw)
+	return p.compile()
+}
+
+// env is the environment the expressions are evaluated in, once for every match
+type env struct {
+	Package packageEnv `expr:"package"`
+	Cycle   cycleEnv   `expr:"cycle"`
+	// the number of days since the cycle EOL date, negative when the date is still in the future and 0 when the
+	// cycle has no EOL date
+	DaysEol   int       `expr:"daysEol"`
+	VulnCount int       `expr:"vulnCount"`
+	Source    sourceEnv `expr:"source"`
+	Project   string    `expr:"project"`
+}
+
+type packageEnv struct {
+	Name     string `expr:"name"`
+	Version  string `expr:"version"`
+	Type     string `expr:"type"`
+	Language string `expr:"language"`
+	PURL     string `expr:"purl"`
+}
+
+type cycleEnv struct {
+	Product      string `expr:"product"`
+	ReleaseCycle string `expr:"releaseCycle"`
+	Eol          string `expr:"eol"`
+	EolBool      bool   `expr:"eolBool"`
+	// whether the cycle is LTS, including cycles whose LTS date has passed
+	LTS bool `expr:"lts"`
+}
+
+type sourceEnv struct {
+	ImageLabels map[string]string `expr:"imageLabels"`
+}
+
+func newEnv(m match.Match, evalCtx types.EvaluationContext) env {
+	now := evalCtx.Now()
+	var daysEol int
+	if m.Cycle.HasEolDate() {
+		days, err := m.Cycle.DaysEol(now)
+		if err == nil {
+			daysEol = days
+		}
+	}
+	labels := evalCtx.ImageLabels
+	if labels == nil {
+		// so that expressions over labels evaluate to false rather than failing for sources other than images
+		labels = map[string]string{}
+	}
+
+	return env{
+		Package: packageEnv{
+			Name:     m.Package.Name,
+			Version:  m.Package.Version,
+			Type:     string(m.Package.Type),
+			Language: string(m.Package.Language),
+			PURL:     m.Package.PURL,
+		},
+		Cycle: cycleEnv{
+			Product:      m.Cycle.ProductName,
+			ReleaseCycle: m.Cycle.ReleaseCycle,
+			Eol:          m.Cycle.Eol,
+			EolBool:      m.Cycle.EolBool,
+			LTS:          m.Cycle.IsLTS(now),
+		},
+		DaysEol:   daysEol,
+		VulnCount: m.VulnCount,
+		Source: sourceEnv{
+			ImageLabels: labels,
+		},
+		Project: evalCtx.ProjectName,
+	}
+}
+
+func compile(expression string) (*vm.Program, error) {
+	if expression == "" {
+		return nil, nil
+	}
+	return expr.Compile(expression, expr.Env(env{}), expr.AsBool())
+}
+
+// compile compiles the expressions of the policy, it fails for policies without any expression or with an
+// expression that does not compile
+func (p *Policy) compile() error {
+	if p.WarnWhen == "" && p.DenyWhen == "" {
+		return fmt.Errorf("expression policy %q has no WarnWhen or DenyWhen expression", p.ID)
+	}
+	warn, err := compile(p.WarnWhen)
+	if err != nil {
+		return fmt.Errorf("invalid WarnWhen expression of policy %q: %w", p.ID, err)
+	}
+	deny, err := compile(p.DenyWhen)
+	if err != nil {
+		return fmt.Errorf("invalid DenyWhen expression of policy %q: %w", p.ID, err)
+	}
+	p.warn, p.deny = warn, deny
+	return nil
+}
+
+func (p Policy) compiled() bool {
+	return p.warn != nil || p.deny != nil
+}
+
+func (e PolicyWrapper) GetPolicyType() types.PolicyType {
+	return e.PolicyType
+}
+
+func (e PolicyWrapper) Evaluate(matches match.Matches, evalCtx types.EvaluationContext) (bool, []types.PolicyEvaluationResult) {
+	var policies []Policy
+	for _, p := range e.Policies {
+		if !p.compiled() {
+			log.Errorf("skipping expression policy %q: its expressions have not been compiled", p.ID)
+			continue
+		}
+		policies = append(policies, p)
+	}
+
+	policyMatches := evaluateMatches(policies, matches, evalCtx)
+
+	// whether we should fail the scan or not
+	failScan := false
+
+	results := make([]types.PolicyEvaluationResult, 0, len(policyMatches))
+	for _, policyMatch := range policyMatches {
+		if policyMatch.Action == types.PolicyActionDeny {
+			failScan = true
+		}
+		bus.Publish(partybus.Event{
+			Type:  event.EolPolicyEvaluationMessage,
+			Value: policyMatch,
+		})
+		results = append(results, policyMatch)
+	}
+
+	return failScan, results
+}
+
+// evaluateMatches returns a result for every product cycle a policy fired for. Deny expressions are evaluated
+// before warn expressions, in policy order, and the first expression that holds determines the action.
+func evaluateMatches(policies []Policy, matches match.Matches, evalCtx types.EvaluationContext) []types.EolEvaluationResult {
+	var results []types.EolEvaluationResult
+
+	// keep track of which cycles have been evaluated so the same cycle is not reported twice
+	evaluated := make(map[string]bool)
+
+	for _, m := range matches.Sorted() {
+		key := m.Cycle.ProductName + "@" + m.Cycle.ReleaseCycle
+		if evaluated[key] {
+			continue
+		}
+		matchEnv := newEnv(m, evalCtx)
+
+		if p := firstHolding(policies, matchEnv, types.PolicyActionDeny); p != nil {
+			results = append(results, createEvaluationResult(*p, m, types.PolicyActionDeny))
+			evaluated[key] = true
+			continue
+		}
+		if p := firstHolding(policies, matchEnv, types.PolicyActionWarn); p != nil {
+			results = append(results, createEvaluationResult(*p, m, types.PolicyActionWarn))
+			evaluated[key] = true
+		}
+	}
+	return results
+}
+
+// firstHolding returns the first policy whose expression for the action holds in the environment
+func firstHolding(policies []Policy, matchEnv env, action types.PolicyAction) *Policy {
+	for i, p := range policies {
+		prog := p.warn
+		if action == types.PolicyActionDeny {
+			prog = p.deny
+		}
+		if prog == nil {
+			continue
+		}
+		out, err := expr.Run(prog, matchEnv)
+		if err != nil {
+			log.Debugf("unable to evaluate expression of policy %q: %v", p.ID, err)
+			continue
+		}
+		if holds, ok := out.(bool); ok && holds {
+			return &policies[i]
+		}
+	}
+	return nil
+}
+
+func createEvaluationResult(policy Policy, m match.Match, policyAction types.PolicyAction) types.EolEvaluationResult {
+	return types.EolEvaluationResult{
+		Action:         policyAction,
+		Type:           types.PolicyTypeExpression,
+		PolicyID:       policy.ID,
+		ProductName:    m.Cycle.ProductName,
+		Cycle:          m.Cycle.ReleaseCycle,
+		PackageID:      string(m.Package.ID),
+		PackageName:    m.Package.Name,
+		PackageVersion: m.Package.Version,
+		PURL:           m.Package.PURL,
+	}
+}
@@ -0,0 +1,295 @@
+package expression
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	syftPkg "github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/policy/types"
+)
+
+func javaMatch(cycle, eolDate, lts string) match.Match {
+	return match.Match{
+		Cycle: eol.Cycle{
+			ProductName:  "Java",
+			ReleaseCycle: cycle,
+			Eol:          eolDate,
+			LTS:          lts,
+		},
+		Package: pkg.Package{
+			ID:       pkg.ID("java@" + cycle),
+			Name:     "java",
+			Version:  cycle + ".0.1",
+			Type:     syftPkg.BinaryPkg,
+			Language: syftPkg.Java,
+		},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	prodLabels := map[string]string{"tier": "prod"}
+
+	tests := []struct {
+		name    string
+		policy  []Policy
+		matches []match.Match
+		evalCtx types.EvaluationContext
+		want    []types.EolEvaluationResult
+	}{
+		{
+			name: "deny non-LTS java runtimes 30 days before EOL in prod images",
+			policy: []Policy{
+				{
+					ID:       "non-lts-java",
+					DenyWhen: `package.language == "java" && !cycle.lts && daysEol >= -30 && source.imageLabels.tier == "prod"`,
+				},
+			},
+			matches: []match.Match{
+				javaMatch("20", "2021-02-15", "false"),
+				javaMatch("17", "2021-02-15", "true"),
+			},
+			evalCtx: types.EvaluationContext{ImageLabels: prodLabels},
+			want: []types.EolEvaluationResult{
+				{
+					Action:         types.PolicyActionDeny,
+					Type:           types.PolicyTypeExpression,
+					PolicyID:       "non-lts-java",
+					ProductName:    "Java",
+					Cycle:          "20",
+					PackageID:      "java@20",
+					PackageName:    "java",
+					PackageVersion: "20.0.1",
+				},
+			},
+		},
+		{
+			name: "label expression does not hold for sources other than images",
+			policy: []Policy{
+				{
+					ID:       "non-lts-java",
+					DenyWhen: `source.imageLabels.tier == "prod"`,
+				},
+			},
+			matches: []match.Match{
+				javaMatch("20", "2021-02-15", "false"),
+			},
+			want: nil,
+		},
+		{
+			name: "deny takes precedence over warn",
+			policy: []Policy{
+				{
+					ID:       "warn-all",
+					WarnWhen: `true`,
+				},
+				{
+					ID:       "deny-eol",
+					DenyWhen: `daysEol > 0`,
+				},
+			},
+			matches: []match.Match{
+				javaMatch("19", "2021-01-01", "false"),
+				javaMatch("21", "2026-01-01", "true"),
+			},
+			want: []types.EolEvaluationResult{
+				{
+					Action:         types.PolicyActionDeny,
+					Type:           types.PolicyTypeExpression,
+					PolicyID:       "deny-eol",
+					ProductName:    "Java",
+					Cycle:          "19",
+					PackageID:      "java@19",
+					PackageName:    "java",
+					PackageVersion: "19.0.1",
+				},
+				{
+					Action:         types.PolicyActionWarn,
+					Type:           types.PolicyTypeExpression,
+					PolicyID:       "warn-all",
+					ProductName:    "Java",
+					Cycle:          "21",
+					PackageID:      "java@21",
+					PackageName:    "java",
+					PackageVersion: "21.0.1",
+				},
+			},
+		},
+		{
+			name: "project and vuln count",
+			policy: []Policy{
+				{
+					ID:       "vulnerable",
+					WarnWhen: `project == "github//foo/bar" && vulnCount > 2`,
+				},
+			},
+			matches: []match.Match{
+				func() match.Match {
+					m := javaMatch("19", "2021-01-01", "false")
+					m.VulnCount = 3
+					return m
+				}(),
+				javaMatch("20", "2021-01-01", "false"),
+			},
+			evalCtx: types.EvaluationContext{ProjectName: "github//foo/bar"},
+			want: []types.EolEvaluationResult{
+				{
+					Action:         types.PolicyActionWarn,
+					Type:           types.PolicyTypeExpression,
+					PolicyID:       "vulnerable",
+					ProductName:    "Java",
+					Cycle:          "19",
+					PackageID:      "java@19",
+					PackageName:    "java",
+					PackageVersion: "19.0.1",
+				},
+			},
+		},
+		{
+			name: "cycles with an LTS date are LTS once the date has passed",
+			policy: []Policy{
+				{
+					ID:       "non-lts-java",
+					DenyWhen: `!cycle.lts`,
+				},
+			},
+			matches: []match.Match{
+				javaMatch("17", "2029-09-30", "2021-01-15"),
+				javaMatch("21", "2031-09-30", "2021-09-14"),
+			},
+			want: []types.EolEvaluationResult{
+				{
+					Action:         types.PolicyActionDeny,
+					Type:           types.PolicyTypeExpression,
+					PolicyID:       "non-lts-java",
+					ProductName:    "Java",
+					Cycle:          "21",
+					PackageID:      "java@21",
+					PackageName:    "java",
+					PackageVersion: "21.0.1",
+				},
+			},
+		},
+		{
+			name: "cycles without an EOL date are 0 days past EOL",
+			policy: []Policy{
+				{
+					ID:       "eol-java",
+					DenyWhen: `daysEol > 0`,
+				},
+			},
+			matches: []match.Match{
+				javaMatch("20", "0001-01-01", "false"),
+			},
+		},
+		{
+			name: "cycle reported once",
+			policy: []Policy{
+				{
+					ID:       "deny-java",
+					DenyWhen: `cycle.product == "Java"`,
+				},
+			},
+			matches: []match.Match{
+				javaMatch("20", "2021-01-01", "false"),
+				func() match.Match {
+					// the same runtime installed twice
+					m := javaMatch("20", "2021-01-01", "false")
+					m.Package.ID = "java@20-copy"
+					return m
+				}(),
+			},
+			want: []types.EolEvaluationResult{
+				{
+					Action:         types.PolicyActionDeny,
+					Type:           types.PolicyTypeExpression,
+					PolicyID:       "deny-java",
+					ProductName:    "Java",
+					Cycle:          "20",
+					PackageID:      "java@20",
+					PackageName:    "java",
+					PackageVersion: "20.0.1",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := range tt.policy {
+				if err := tt.policy[i].compile(); err != nil {
+					t.Fatalf("unable to compile policy: %v", err)
+				}
+			}
+
+			matches := match.NewMatches(tt.matches...)
+			evalCtx := tt.evalCtx
+			evalCtx.EvaluationTime = time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+			policyMatches := evaluateMatches(tt.policy, matches, evalCtx)
+			if !reflect.DeepEqual(policyMatches, tt.want) {
+				t.Errorf("expected policy matches to be %v, got %v", tt.want, policyMatches)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			policy: `{"ID": "valid", "WarnWhen": "daysEol > -90", "DenyWhen": "daysEol > 0"}`,
+		},
+		{
+			name:    "no expression",
+			policy:  `{"ID": "empty"}`,
+			wantErr: "has no WarnWhen or DenyWhen expression",
+		},
+		{
+			name:    "unknown variable",
+			policy:  `{"ID": "typo", "DenyWhen": "package.kind == \"npm\""}`,
+			wantErr: "invalid DenyWhen expression",
+		},
+		{
+			name:    "not a boolean",
+			policy:  `{"ID": "number", "WarnWhen": "daysEol + 1"}`,
+			wantErr: "invalid WarnWhen expression",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p Policy
+			err := json.Unmarshal([]byte(tt.policy), &p)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, p.compiled())
+			assert.NotNil(t, p.warn)
+			assert.NotNil(t, p.deny)
+		})
+	}
+}
+
+func TestEvaluateSkipsPoliciesNotCompiled(t *testing.T) {
+	wrapper := PolicyWrapper{
+		PolicyType: types.PolicyTypeExpression,
+		Policies:   []Policy{{ID: "deny-java", DenyWhen: `cycle.product == "Java"`}},
+	}
+
+	failScan, results := wrapper.Evaluate(match.NewMatches(javaMatch("20", "2021-01-01", "false")), types.EvaluationContext{})
+	assert.False(t, failScan)
+	assert.Empty(t, results)
+}
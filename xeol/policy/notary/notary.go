@@ -15,8 +15,6 @@ import (
 	"github.com/xeol-io/xeol/xeol/policy/types"
 )
 
-var timeNow = time.Now
-
 const (
 	DateLayout = "2006-01-02"
 )
@@ -36,7 +34,7 @@ func (n PolicyWrapper) GetPolicyType() types.PolicyType {
 	return n.PolicyType
 }
 
-func (n Policy) warnMatch() bool {
+func (n Policy) warnMatch(now time.Time) bool {
 	if n.WarnDate != "" {
 		warnDate, err := time.Parse(DateLayout, n.WarnDate)
 		if err != nil {
@@ -48,14 +46,14 @@ func (n Policy) warnMatch() bool {
 			return false
 		}
 
-		if timeNow().After(warnDate) {
+		if now.After(warnDate) {
 			return true
 		}
 	}
 	return false
 }
 
-func (n Policy) denyMatch() bool {
+func (n Policy) denyMatch(now time.Time) bool {
 	if n.DenyDate != "" {
 		denyDate, err := time.Parse(DateLayout, n.DenyDate)
 		if err != nil {
@@ -67,7 +65,7 @@ func (n Policy) denyMatch() bool {
 			return false
 		}
 
-		if timeNow().After(denyDate) {
+		if now.After(denyDate) {
 			return true
 		}
 	}
@@ -75,29 +73,30 @@ func (n Policy) denyMatch() bool {
 }
 
 //nolint:funlen
-func (n PolicyWrapper) Evaluate(_ match.Matches, _ string, imageReference string, certsPEM string) (bool, types.PolicyEvaluationResult) {
+func (n PolicyWrapper) Evaluate(_ match.Matches, evalCtx types.EvaluationContext) (bool, []types.PolicyEvaluationResult) {
 	ctx := context.Background()
+	imageReference, certsPEM := evalCtx.ImageReference, evalCtx.CertificatesPEM
 
 	if certsPEM == "" {
 		log.Debugf("no notary certificates set, skipping notary evaluation")
-		return false, types.NotaryEvaluationResult{}
+		return false, nil
 	}
 
 	if len(n.Policies) == 0 {
 		log.Errorf("no notary policies provided")
-		return false, types.NotaryEvaluationResult{}
+		return false, nil
 	}
 
 	if len(n.Policies) > 1 {
 		log.Errorf("invalid number of notary policies, there should only be one: %d", len(n.Policies))
-		return false, types.NotaryEvaluationResult{}
+		return false, nil
 	}
 
 	// validate this is a docker image reference
 	isValid := reference.ReferenceRegexp.MatchString(imageReference)
 	if !isValid {
 		log.Errorf("invalid Docker image reference: %s", imageReference)
-		return false, types.NotaryEvaluationResult{}
+		return false, nil
 	}
 
 	policy := n.Policies[0]
@@ -105,16 +104,16 @@ func (n PolicyWrapper) Evaluate(_ match.Matches, _ string, imageReference string
 	err := sigverifier.Verify(ctx, imageReference, policy.Policy, certsPEM)
 	// if err is nil, then the image is verified
 	if err == nil {
-		return failBuild, types.NotaryEvaluationResult{
+		return failBuild, []types.PolicyEvaluationResult{types.NotaryEvaluationResult{
 			Action:         types.PolicyActionAllow,
 			Type:           types.PolicyTypeNotary,
 			ImageReference: imageReference,
 			Verified:       true,
-		}
+		}}
 	}
 	log.Debugf("signature verification failed: %v", err)
 
-	if policy.denyMatch() {
+	if policy.denyMatch(evalCtx.Now()) {
 		failBuild = true
 		result := types.NotaryEvaluationResult{
 			Action:         types.PolicyActionDeny,
@@ -126,10 +125,10 @@ func (n PolicyWrapper) Evaluate(_ match.Matches, _ string, imageReference string
 			Type:  event.NotaryPolicyEvaluationMessage,
 			Value: result,
 		})
-		return failBuild, result
+		return failBuild, []types.PolicyEvaluationResult{result}
 	}
 
-	if policy.warnMatch() {
+	if policy.warnMatch(evalCtx.Now()) {
 		result := types.NotaryEvaluationResult{
 			Action:         types.PolicyActionWarn,
 			Type:           types.PolicyTypeNotary,
@@ -141,8 +140,8 @@ func (n PolicyWrapper) Evaluate(_ match.Matches, _ string, imageReference string
 			Type:  event.NotaryPolicyEvaluationMessage,
 			Value: result,
 		})
-		return failBuild, result
+		return failBuild, []types.PolicyEvaluationResult{result}
 	}
 
-	return failBuild, types.NotaryEvaluationResult{}
+	return failBuild, nil
 }
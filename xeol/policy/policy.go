@@ -1,17 +1,23 @@
 package policy
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
 
 	"github.com/xeol-io/xeol/xeol/match"
 	"github.com/xeol-io/xeol/xeol/policy/eol"
+	"github.com/xeol-io/xeol/xeol/policy/expression"
 	"github.com/xeol-io/xeol/xeol/policy/notary"
 	"github.com/xeol-io/xeol/xeol/policy/types"
 )
 
 type Policy interface {
-	Evaluate(match.Matches, string, string, string) (bool, types.PolicyEvaluationResult)
+	// Evaluate returns whether the scan should fail, along with the result of every policy that fired
+	Evaluate(match.Matches, types.EvaluationContext) (bool, []types.PolicyEvaluationResult)
 	GetPolicyType() types.PolicyType
 }
 
@@ -40,6 +46,17 @@ func UnmarshalPolicies(data []byte) ([]Policy, error) {
 				return nil, err
 			}
 			policies = append(policies, container)
+		case "EXPRESSION":
+			var container expression.PolicyWrapper
+			rawJSON, err := json.Marshal(rawPolicy)
+			if err != nil {
+				return nil, err
+			}
+			// the expressions are compiled, and invalid ones reported, while unmarshalling
+			if err := json.Unmarshal(rawJSON, &container); err != nil {
+				return nil, err
+			}
+			policies = append(policies, container)
 		case "NOTARY":
 			var container notary.PolicyWrapper
 			rawJSON, err := json.Marshal(rawPolicy)
@@ -57,3 +74,59 @@ func UnmarshalPolicies(data []byte) ([]Policy, error) {
 
 	return policies, nil
 }
+
+// LoadPolicies reads policies from a local YAML or JSON file. The file holds the same policy model as the
+// xeol.io API: a list of policy wrappers (or a single wrapper), each with a PolicyType and its Policies.
+func LoadPolicies(path string) ([]Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read policy file: %w", err)
+	}
+
+	// YAML is a superset of JSON, so both are decoded the same way and converted to JSON for UnmarshalPolicies
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("unable to parse policy file %q: %w", path, err)
+	}
+	if _, ok := raw.(map[string]interface{}); ok {
+		raw = []interface{}{raw}
+	}
+	rawJSON, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse policy file %q: %w", path, err)
+	}
+	if bytes.Equal(rawJSON, []byte("null")) {
+		return nil, nil
+	}
+
+	policies, err := UnmarshalPolicies(rawJSON)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy file %q: %w", path, err)
+	}
+	return policies, nil
+}
+
+// MergePolicies combines policies from several sources (e.g. local policy files and xeol.io). The EOL policies
+// of all sources are merged into a single EOL policy, so that scope priority (software > project > global)
+// applies across sources and each product is evaluated once. Other policies are kept as they are.
+func MergePolicies(sources ...[]Policy) []Policy {
+	var merged []Policy
+	var eolPolicy *eol.PolicyWrapper
+	for _, policies := range sources {
+		for _, p := range policies {
+			wrapper, ok := p.(eol.PolicyWrapper)
+			if !ok {
+				merged = append(merged, p)
+				continue
+			}
+			if eolPolicy == nil {
+				eolPolicy = &eol.PolicyWrapper{PolicyType: wrapper.PolicyType}
+			}
+			eolPolicy.Policies = append(eolPolicy.Policies, wrapper.Policies...)
+		}
+	}
+	if eolPolicy != nil {
+		merged = append([]Policy{*eolPolicy}, merged...)
+	}
+	return merged
+}
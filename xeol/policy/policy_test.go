@@ -0,0 +1,159 @@
+package policy
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/policy/eol"
+	"github.com/xeol-io/xeol/xeol/policy/expression"
+	"github.com/xeol-io/xeol/xeol/policy/notary"
+	"github.com/xeol-io/xeol/xeol/policy/types"
+)
+
+func Int(value int) *int {
+	return &value
+}
+
+// compiledExpressionPolicy returns the policy as it is after unmarshalling, with its expressions compiled
+func compiledExpressionPolicy(t *testing.T, p expression.Policy) expression.Policy {
+	t.Helper()
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+
+	var compiled expression.Policy
+	require.NoError(t, json.Unmarshal(data, &compiled))
+	return compiled
+}
+
+func TestLoadPolicies(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    []Policy
+		wantErr bool
+	}{
+		{
+			name: "yaml list of policies",
+			path: "test-fixtures/policy.yaml",
+			want: []Policy{
+				eol.PolicyWrapper{
+					PolicyType: types.PolicyTypeEol,
+					Policies: []eol.Policy{
+						{
+							PolicyScope:   eol.PolicyScopeSoftware,
+							ProductName:   "Python",
+							Cycle:         "3.7",
+							CycleOperator: eol.CycleOperatorLessThanOrEqual,
+							DenyDate:      "2024-01-01",
+						},
+						{
+							PolicyScope: eol.PolicyScopeGlobal,
+							WarnDays:    Int(90),
+							DenyDays:    Int(0),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "json single policy",
+			path: "test-fixtures/policy.json",
+			want: []Policy{
+				eol.PolicyWrapper{
+					PolicyType: types.PolicyTypeEol,
+					Policies: []eol.Policy{
+						{
+							PolicyScope: eol.PolicyScopeProject,
+							ProjectName: "github//xeol-io/xeol",
+							DenyDate:    "2024-06-01",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "yaml expression policy",
+			path: "test-fixtures/expression-policy.yaml",
+			want: []Policy{
+				expression.PolicyWrapper{
+					PolicyType: types.PolicyTypeExpression,
+					Policies: []expression.Policy{
+						compiledExpressionPolicy(t, expression.Policy{
+							ID:       "non-lts-java",
+							DenyWhen: `package.language == "java" && !cycle.lts && daysEol >= -30 && source.imageLabels.tier == "prod"`,
+							WarnWhen: "daysEol >= -90",
+						}),
+					},
+				},
+			},
+		},
+		{
+			name:    "missing file",
+			path:    "test-fixtures/missing.yaml",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadPolicies(tt.path)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLoadPoliciesUnknownType(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("- PolicyType: UNKNOWN\n"), 0600))
+
+	_, err := LoadPolicies(path)
+	assert.ErrorContains(t, err, "unknown policy type")
+}
+
+func TestLoadPoliciesInvalidExpression(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("- PolicyType: EXPRESSION\n  Policies:\n    - ID: typo\n      DenyWhen: package.kind == \"npm\"\n"), 0600))
+
+	_, err := LoadPolicies(path)
+	assert.ErrorContains(t, err, "invalid DenyWhen expression")
+}
+
+func TestMergePolicies(t *testing.T) {
+	local := []Policy{
+		eol.PolicyWrapper{
+			PolicyType: types.PolicyTypeEol,
+			Policies:   []eol.Policy{{PolicyScope: eol.PolicyScopeGlobal, DenyDate: "2024-01-01"}},
+		},
+	}
+	remote := []Policy{
+		notary.PolicyWrapper{PolicyType: types.PolicyTypeNotary},
+		eol.PolicyWrapper{
+			PolicyType: types.PolicyTypeEol,
+			Policies:   []eol.Policy{{PolicyScope: eol.PolicyScopeProject, ProjectName: "foo", WarnDate: "2023-01-01"}},
+		},
+	}
+
+	assert.Equal(t, []Policy{
+		eol.PolicyWrapper{
+			PolicyType: types.PolicyTypeEol,
+			Policies: []eol.Policy{
+				{PolicyScope: eol.PolicyScopeGlobal, DenyDate: "2024-01-01"},
+				{PolicyScope: eol.PolicyScopeProject, ProjectName: "foo", WarnDate: "2023-01-01"},
+			},
+		},
+		notary.PolicyWrapper{PolicyType: types.PolicyTypeNotary},
+	}, MergePolicies(local, remote))
+
+	assert.Empty(t, MergePolicies(nil, nil))
+	assert.Equal(t, remote[:1], MergePolicies(nil, remote[:1]))
+}
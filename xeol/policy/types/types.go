@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 type PolicyType string
 
 const (
@@ -7,18 +9,43 @@ const (
 	PolicyActionDeny  PolicyAction = "DENY"
 	PolicyActionAllow PolicyAction = "ALLOW"
 
-	PolicyTypeEol    PolicyType = "EOL"
-	PolicyTypeNotary PolicyType = "NOTARY"
+	PolicyTypeEol        PolicyType = "EOL"
+	PolicyTypeNotary     PolicyType = "NOTARY"
+	PolicyTypeExpression PolicyType = "EXPRESSION"
 )
 
 type PolicyAction string
 
+// EvaluationContext describes the scan that policies are evaluated for
+type EvaluationContext struct {
+	ProjectName     string
+	ImageReference  string            // the image reference, when scanning an image
+	ImageLabels     map[string]string // the image labels, when scanning an image
+	CertificatesPEM string            // the certificates to verify image signatures with
+	EvaluationTime  time.Time         // the time policies are evaluated at, e.g. to preview a deny date
+}
+
+// Now returns the time policies are evaluated at, the current time unless EvaluationTime is set
+func (c EvaluationContext) Now() time.Time {
+	if c.EvaluationTime.IsZero() {
+		return time.Now()
+	}
+	return c.EvaluationTime
+}
+
 type EolEvaluationResult struct {
 	Type        PolicyType
 	Action      PolicyAction
+	PolicyID    string
+	PolicyScope string
 	ProductName string
 	Cycle       string
-	FailDate    string
+	// the package of the match the policy fired for
+	PackageID      string
+	PackageName    string
+	PackageVersion string
+	PURL           string
+	FailDate       string
 }
 
 type NotaryEvaluationResult struct {
@@ -0,0 +1,129 @@
+package csv
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xeol-io/xeol/xeol/match"
+)
+
+// Column is a column of the CSV/TSV report
+type Column string
+
+const (
+	NameColumn          Column = "name"
+	VersionColumn       Column = "version"
+	TypeColumn          Column = "type"
+	PURLColumn          Column = "purl"
+	ProductColumn       Column = "product"
+	CycleColumn         Column = "cycle"
+	EolDateColumn       Column = "eol-date"
+	DaysEolColumn       Column = "days-eol"
+	StatusColumn        Column = "status"
+	WindowColumn        Column = "window"
+	LTSColumn           Column = "lts"
+	LatestReleaseColumn Column = "latest-release"
+	LocationsColumn     Column = "locations"
+	VulnCountColumn     Column = "vuln-count"
+)
+
+// DefaultColumns are the columns of the report, in order, when no columns are selected
+var DefaultColumns = []Column{
+	NameColumn,
+	VersionColumn,
+	TypeColumn,
+	PURLColumn,
+	ProductColumn,
+	CycleColumn,
+	EolDateColumn,
+	DaysEolColumn,
+	LTSColumn,
+	LatestReleaseColumn,
+	LocationsColumn,
+	VulnCountColumn,
+}
+
+// AllColumns are all the columns that can be selected
+var AllColumns = append(append([]Column{}, DefaultColumns...), StatusColumn, WindowColumn)
+
+// ParseColumns returns the columns for the given (case-insensitive) column names, in the given order.
+// DefaultColumns is returned when no names are given.
+func ParseColumns(names []string) ([]Column, error) {
+	if len(names) == 0 {
+		return DefaultColumns, nil
+	}
+
+	columns := make([]Column, 0, len(names))
+	for _, name := range names {
+		c := Column(strings.ToLower(strings.TrimSpace(name)))
+		if !isColumn(c) {
+			return nil, fmt.Errorf("unsupported column %q, supported columns are: %+v", name, AllColumns)
+		}
+		columns = append(columns, c)
+	}
+	return columns, nil
+}
+
+func isColumn(c Column) bool {
+	for _, d := range AllColumns {
+		if c == d {
+			return true
+		}
+	}
+	return false
+}
+
+// value returns the value of the column for the given match
+func (c Column) value(m match.Match, now time.Time) (string, error) {
+	switch c {
+	case NameColumn:
+		return m.Package.Name, nil
+	case VersionColumn:
+		return m.Package.Version, nil
+	case TypeColumn:
+		return string(m.Package.Type), nil
+	case PURLColumn:
+		return m.Package.PURL, nil
+	case ProductColumn:
+		return m.Cycle.ProductName, nil
+	case CycleColumn:
+		return m.Cycle.ReleaseCycle, nil
+	case EolDateColumn:
+		if m.Cycle.EolBool {
+			return "true", nil
+		}
+		if !m.Cycle.HasEolDate() {
+			return "", nil
+		}
+		return m.Cycle.Eol, nil
+	case DaysEolColumn:
+		if m.Cycle.EolBool || !m.Cycle.HasEolDate() {
+			return "", nil
+		}
+		days, err := m.Cycle.DaysEol(now)
+		if err != nil {
+			return "", fmt.Errorf("unable to parse EOL date for package %s: %w", m.Package.PURL, err)
+		}
+		return strconv.Itoa(days), nil
+	case StatusColumn:
+		return string(m.StatusAt(now)), nil
+	case WindowColumn:
+		return m.Window, nil
+	case LTSColumn:
+		return m.Cycle.LTS, nil
+	case LatestReleaseColumn:
+		return m.Cycle.LatestRelease, nil
+	case LocationsColumn:
+		var locations []string
+		for _, l := range m.Package.Locations.ToSlice() {
+			locations = append(locations, l.RealPath)
+		}
+		return strings.Join(locations, ";"), nil
+	case VulnCountColumn:
+		return strconv.Itoa(m.VulnCount), nil
+	default:
+		return "", fmt.Errorf("unsupported column %q", c)
+	}
+}
@@ -0,0 +1,77 @@
+package csv
+
+import (
+	stdcsv "encoding/csv"
+	"io"
+	"time"
+
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+var now = time.Now
+
+// Presenter writes one row per match, with a header row naming the selected columns
+type Presenter struct {
+	matches   match.Matches
+	columns   []string
+	delimiter rune
+}
+
+// NewCSVPresenter is a *Presenter constructor for comma-separated values. Columns are selected and ordered by
+// name, DefaultColumns are used when no columns are given.
+func NewCSVPresenter(pb models.PresenterConfig, columns []string) *Presenter {
+	return &Presenter{
+		matches:   pb.Matches,
+		columns:   columns,
+		delimiter: ',',
+	}
+}
+
+// NewTSVPresenter is a *Presenter constructor for tab-separated values. Columns are selected and ordered by
+// name, DefaultColumns are used when no columns are given.
+func NewTSVPresenter(pb models.PresenterConfig, columns []string) *Presenter {
+	return &Presenter{
+		matches:   pb.Matches,
+		columns:   columns,
+		delimiter: '\t',
+	}
+}
+
+// Present creates a CSV/TSV report
+func (pres *Presenter) Present(output io.Writer) error {
+	columns, err := ParseColumns(pres.columns)
+	if err != nil {
+		return err
+	}
+
+	w := stdcsv.NewWriter(output)
+	w.Comma = pres.delimiter
+
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = string(c)
+	}
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, m := range pres.matches.Sorted() {
+		if m.Package.Name == "" {
+			continue
+		}
+
+		row := make([]string, len(columns))
+		for i, c := range columns {
+			if row[i], err = c.value(m, now()); err != nil {
+				return err
+			}
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
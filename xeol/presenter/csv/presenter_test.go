@@ -0,0 +1,97 @@
+package csv
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/presenter/internal"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+func TestCSVPresenter(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	pb := models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+	}
+
+	now = func() time.Time { return time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	pres := NewCSVPresenter(pb, nil)
+
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	snaps.MatchSnapshot(t, buffer.String())
+}
+
+func TestTSVPresenterWithColumns(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	pb := models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+	}
+
+	now = func() time.Time { return time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	pres := NewTSVPresenter(pb, []string{"product", "cycle", "name", "days-eol"})
+
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	snaps.MatchSnapshot(t, buffer.String())
+}
+
+func TestParseColumns(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    []string
+		expected []Column
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "default columns",
+			input:    nil,
+			expected: DefaultColumns,
+			wantErr:  require.NoError,
+		},
+		{
+			name:     "selected and ordered columns",
+			input:    []string{"EOL-Date", " name "},
+			expected: []Column{EolDateColumn, NameColumn},
+			wantErr:  require.NoError,
+		},
+		{
+			name:     "status columns are only included when selected",
+			input:    []string{"name", "status", "window"},
+			expected: []Column{NameColumn, StatusColumn, WindowColumn},
+			wantErr:  require.NoError,
+		},
+		{
+			name:    "unknown column",
+			input:   []string{"name", "severity"},
+			wantErr: require.Error,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ParseColumns(tc.input)
+			tc.wantErr(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
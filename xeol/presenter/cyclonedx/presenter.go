@@ -0,0 +1,150 @@
+package cyclonedx
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/format/common/cyclonedxhelpers"
+	"github.com/anchore/syft/syft/sbom"
+
+	"github.com/xeol-io/xeol/internal"
+	"github.com/xeol-io/xeol/internal/version"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+const propertyPrefix = "xeol:eol"
+
+// Presenter writes a CycloneDX BOM of the scanned SBOM, enriched with EOL findings
+type Presenter struct {
+	matches match.Matches
+	sbom    *sbom.SBOM
+	format  cyclonedx.BOMFileFormat
+}
+
+// NewJSONPresenter is a *Presenter constructor for CycloneDX JSON output
+func NewJSONPresenter(pb models.PresenterConfig) *Presenter {
+	return &Presenter{
+		matches: pb.Matches,
+		sbom:    pb.SBOM,
+		format:  cyclonedx.BOMFileFormatJSON,
+	}
+}
+
+// NewXMLPresenter is a *Presenter constructor for CycloneDX XML output
+func NewXMLPresenter(pb models.PresenterConfig) *Presenter {
+	return &Presenter{
+		matches: pb.Matches,
+		sbom:    pb.SBOM,
+		format:  cyclonedx.BOMFileFormatXML,
+	}
+}
+
+// Present creates a CycloneDX-based report
+func (pres *Presenter) Present(output io.Writer) error {
+	if pres.sbom == nil {
+		return fmt.Errorf("unable to create CycloneDX report: no SBOM available for the scanned source")
+	}
+
+	bom := cyclonedxhelpers.ToFormatModel(*pres.sbom)
+	addTool(bom)
+	pres.addEolProperties(bom)
+
+	enc := cyclonedx.NewBOMEncoder(output, pres.format)
+	enc.SetPretty(true)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(bom)
+}
+
+// addEolProperties attaches the EOL cycle of every match to its component. Components are related to packages
+// by their BOM reference, which syft derives from the package ID. Distro matches are attached to the OS component.
+func (pres *Presenter) addEolProperties(bom *cyclonedx.BOM) {
+	if bom.Components == nil {
+		return
+	}
+
+	byPackage := make(map[pkg.ID][]match.Match)
+	var distroMatches []match.Match
+	for _, m := range pres.matches.Sorted() {
+		if m.Package.Type == "os" {
+			distroMatches = append(distroMatches, m)
+			continue
+		}
+		byPackage[m.Package.ID] = append(byPackage[m.Package.ID], m)
+	}
+
+	components := *bom.Components
+	for i := range components {
+		if components[i].Type == cyclonedx.ComponentTypeOS {
+			for _, m := range distroMatches {
+				appendProperties(&components[i], m)
+			}
+			continue
+		}
+		for _, m := range byPackage[componentPackageID(components[i])] {
+			appendProperties(&components[i], m)
+		}
+	}
+}
+
+// componentPackageID returns the syft package ID of a component. syft sets the BOM reference to the package PURL
+// with a package-id qualifier, or to the bare package ID when the package has no valid PURL.
+func componentPackageID(c cyclonedx.Component) pkg.ID {
+	ref, err := packageurl.FromString(c.BOMRef)
+	if err != nil {
+		return pkg.ID(c.BOMRef)
+	}
+	if id, ok := ref.Qualifiers.Map()["package-id"]; ok {
+		return pkg.ID(id)
+	}
+	return pkg.ID(c.BOMRef)
+}
+
+func appendProperties(c *cyclonedx.Component, m match.Match) {
+	cycle := models.NewCycle(m.Cycle)
+
+	var props []cyclonedx.Property
+	if c.Properties != nil {
+		props = *c.Properties
+	}
+
+	for _, p := range []cyclonedx.Property{
+		{Name: propertyPrefix + ":productName", Value: cycle.ProductName},
+		{Name: propertyPrefix + ":productPermalink", Value: cycle.ProductPermalink},
+		{Name: propertyPrefix + ":releaseCycle", Value: cycle.ReleaseCycle},
+		{Name: propertyPrefix + ":eolDate", Value: cycle.Eol},
+		{Name: propertyPrefix + ":lts", Value: m.Cycle.LTS},
+		{Name: propertyPrefix + ":latestRelease", Value: cycle.LatestRelease},
+		{Name: propertyPrefix + ":status", Value: string(m.Status)},
+		{Name: propertyPrefix + ":window", Value: m.Window},
+	} {
+		if p.Value == "" {
+			continue
+		}
+		props = append(props, p)
+	}
+
+	c.Properties = &props
+}
+
+func addTool(bom *cyclonedx.BOM) {
+	if bom.Metadata == nil {
+		bom.Metadata = &cyclonedx.Metadata{}
+	}
+	if bom.Metadata.Tools == nil {
+		bom.Metadata.Tools = &cyclonedx.ToolsChoice{}
+	}
+	if bom.Metadata.Tools.Components == nil {
+		bom.Metadata.Tools.Components = &[]cyclonedx.Component{}
+	}
+
+	*bom.Metadata.Tools.Components = append(*bom.Metadata.Tools.Components, cyclonedx.Component{
+		Type:    cyclonedx.ComponentTypeApplication,
+		Author:  internal.ApplicationName,
+		Name:    internal.ApplicationName,
+		Version: version.FromBuild().Version,
+	})
+}
@@ -0,0 +1,151 @@
+package cyclonedx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/anchore/syft/syft/format/common/cyclonedxhelpers"
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/presenter/internal"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+func TestCycloneDxPresenterJSON(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+	s := internal.SBOMFromPackages(t, packages)
+	s.Artifacts.LinuxDistribution = context.Distro
+
+	pb := models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+		SBOM:     s,
+	}
+
+	pres := NewJSONPresenter(pb)
+
+	// run presenter
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := internal.Redact(buffer.Bytes())
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestCycloneDxPresenterXML(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+	s := internal.SBOMFromPackages(t, packages)
+	s.Artifacts.LinuxDistribution = context.Distro
+
+	pb := models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+		SBOM:     s,
+	}
+
+	pres := NewXMLPresenter(pb)
+
+	// run presenter
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := internal.Redact(buffer.Bytes())
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestCycloneDxPresenterAddsEolProperties(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+	s := internal.SBOMFromPackages(t, packages)
+	s.Artifacts.LinuxDistribution = context.Distro
+
+	pres := NewJSONPresenter(models.PresenterConfig{
+		Matches: matches,
+		SBOM:    s,
+	})
+	require.NoError(t, pres.Present(&buffer))
+
+	assert.Equal(t, map[string]string{
+		"package-1": "2018-07-31",
+		"package-2": "true",
+		"os":        "2021-04-02",
+	}, componentEolDates(t, &buffer))
+}
+
+func TestCycloneDxPresenterPackageWithoutComponent(t *testing.T) {
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+	s := internal.SBOMFromPackages(t, packages)
+	s.Artifacts.LinuxDistribution = context.Distro
+
+	// drop the component of the first package, so components and SBOM packages no longer line up
+	bom := cyclonedxhelpers.ToFormatModel(*s)
+	var components []cyclonedx.Component
+	for _, c := range *bom.Components {
+		if c.Name != "package-1" {
+			components = append(components, c)
+		}
+	}
+	bom.Components = &components
+
+	pres := NewJSONPresenter(models.PresenterConfig{
+		Matches: matches,
+		SBOM:    s,
+	})
+	pres.addEolProperties(bom)
+
+	var buffer bytes.Buffer
+	require.NoError(t, cyclonedx.NewBOMEncoder(&buffer, cyclonedx.BOMFileFormatJSON).Encode(bom))
+
+	assert.Equal(t, map[string]string{
+		"package-2": "true",
+		"os":        "2021-04-02",
+	}, componentEolDates(t, &buffer))
+}
+
+func TestCycloneDxPresenterRequiresSBOM(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, _, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	pres := NewJSONPresenter(models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+	})
+
+	assert.Error(t, pres.Present(&buffer))
+}
+
+// componentEolDates decodes a CycloneDX JSON BOM and returns the EOL date property of every component
+func componentEolDates(t *testing.T, buffer *bytes.Buffer) map[string]string {
+	t.Helper()
+
+	bom := cyclonedx.NewBOM()
+	require.NoError(t, cyclonedx.NewBOMDecoder(buffer, cyclonedx.BOMFileFormatJSON).Decode(bom))
+	require.NotNil(t, bom.Components)
+
+	eolDates := make(map[string]string)
+	for _, c := range *bom.Components {
+		if c.Properties == nil {
+			continue
+		}
+		name := c.Name
+		if c.Type == cyclonedx.ComponentTypeOS {
+			name = "os"
+		}
+		for _, p := range *c.Properties {
+			if p.Name == "xeol:eol:eolDate" {
+				eolDates[name] = p.Value
+			}
+		}
+	}
+
+	return eolDates
+}
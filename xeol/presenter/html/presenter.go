@@ -0,0 +1,296 @@
+package html
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	syftSource "github.com/anchore/syft/syft/source"
+
+	"github.com/xeol-io/xeol/internal"
+	"github.com/xeol-io/xeol/internal/version"
+	"github.com/xeol-io/xeol/xeol/distro"
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+var now = time.Now
+
+//go:embed report.html.tmpl
+var reportTemplate string
+
+var tmpl = template.Must(template.New("report").Parse(reportTemplate))
+
+// Presenter writes a single self-contained HTML report, with no external assets
+type Presenter struct {
+	matches           match.Matches
+	context           pkg.Context
+	policyEvaluations []models.PolicyEvaluation
+	showVulnCount     bool
+}
+
+// NewPresenter is a *Presenter constructor
+func NewPresenter(pb models.PresenterConfig) *Presenter {
+	return &Presenter{
+		matches:           pb.Matches,
+		context:           pb.Context,
+		policyEvaluations: models.NewPolicyEvaluations(pb.PolicyEvaluations),
+		showVulnCount:     pb.ShowVulnCount,
+	}
+}
+
+// Present creates an HTML report
+func (pres *Presenter) Present(output io.Writer) error {
+	r, err := pres.report()
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(output, r)
+}
+
+func (pres *Presenter) report() (*report, error) {
+	today := now()
+
+	var matches []match.Match
+	for _, m := range pres.matches.Sorted() {
+		if m.Package.Name == "" {
+			continue
+		}
+		matches = append(matches, m)
+	}
+
+	r := &report{
+		GeneratedAt:       today.UTC().Format(time.RFC1123),
+		Version:           version.FromBuild().Version,
+		Source:            pres.sourceInfo(),
+		Distro:            pres.distroInfo(),
+		ShowVulnCount:     pres.showVulnCount,
+		PolicyEvaluations: pres.policyEvaluations,
+	}
+
+	r.Title = fmt.Sprintf("%s EOL report", internal.ApplicationName)
+	if r.Source.Name != "" {
+		r.Title = fmt.Sprintf("%s EOL report for %s", internal.ApplicationName, r.Source.Name)
+	}
+
+	packages := make(map[pkg.ID]struct{})
+	for _, m := range matches {
+		rw, err := newRow(m, today)
+		if err != nil {
+			return nil, err
+		}
+		r.Rows = append(r.Rows, rw)
+		packages[m.Package.ID] = struct{}{}
+
+		switch match.Status(rw.Status) {
+		case match.StatusEol:
+			r.Summary.Eol++
+		case match.StatusEolWithinWindow:
+			r.Summary.EolSoon++
+		default:
+			r.Summary.Other++
+		}
+	}
+	r.Summary.Packages = len(packages)
+
+	r.Timeline = newTimeline(matches, today)
+	r.Summary.Products = len(r.Timeline.Products)
+
+	return r, nil
+}
+
+func newRow(m match.Match, today time.Time) (row, error) {
+	rw := row{
+		Name:        m.Package.Name,
+		Version:     m.Package.Version,
+		Type:        string(m.Package.Type),
+		Product:     m.Cycle.ProductName,
+		Permalink:   m.Cycle.ProductPermalink,
+		Cycle:       m.Cycle.ReleaseCycle,
+		PURL:        m.Package.PURL,
+		VulnCount:   m.VulnCount,
+		Status:      string(m.StatusAt(today)),
+		StatusLabel: m.StatusLabel(today),
+	}
+
+	for _, l := range m.Package.Locations.ToSlice() {
+		rw.Locations = append(rw.Locations, l.RealPath)
+	}
+
+	if m.Cycle.EolBool {
+		rw.Eol = "YES"
+		rw.DaysEol = "-"
+		rw.DaysEolSort = int(^uint(0) >> 1)
+		return rw, nil
+	}
+
+	if !m.Cycle.HasEolDate() {
+		// the cycle is unknown or has no EOL date, sort it last
+		rw.Eol = "-"
+		rw.DaysEol = "-"
+		rw.DaysEolSort = -int(^uint(0)>>1) - 1
+		return rw, nil
+	}
+
+	days, err := m.Cycle.DaysEol(today)
+	if err != nil {
+		return row{}, fmt.Errorf("unable to parse EOL date for package %s: %w", m.Package.PURL, err)
+	}
+
+	rw.Eol = m.Cycle.Eol
+	rw.DaysEolSort = days
+	rw.DaysEol = strconv.Itoa(days)
+	if days < 0 {
+		// the cycle is not EOL yet
+		rw.DaysEol = fmt.Sprintf("in %d days", -days)
+	}
+	return rw, nil
+}
+
+// newTimeline lays out the release → EOL range of every matched cycle, grouped by product. Cycles
+// without an EOL date (boolean EOL or no EOL data) are drawn up to today, unknown cycles are left out.
+func newTimeline(matches []match.Match, today time.Time) timeline {
+	type span struct {
+		cycle  eol.Cycle
+		status match.Status
+		start  time.Time
+		end    time.Time
+	}
+
+	var productOrder []string
+	seen := make(map[string]struct{})
+	spans := make(map[string][]span)
+	permalinks := make(map[string]string)
+
+	start, end := today, today
+	for _, m := range matches {
+		if m.Cycle.ReleaseCycle == "" {
+			continue
+		}
+
+		key := m.Cycle.ProductName + "@" + m.Cycle.ReleaseCycle
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		s := span{cycle: m.Cycle, status: m.StatusAt(today), end: today}
+		if !m.Cycle.EolBool && m.Cycle.HasEolDate() {
+			if d, err := time.Parse(eol.DateLayout, m.Cycle.Eol); err == nil {
+				s.end = d
+			}
+		}
+		s.start = s.end
+		if d, err := time.Parse(eol.DateLayout, m.Cycle.ReleaseDate); err == nil && d.Before(s.end) {
+			s.start = d
+		}
+
+		if s.start.Before(start) {
+			start = s.start
+		}
+		if s.end.After(end) {
+			end = s.end
+		}
+
+		if _, ok := spans[m.Cycle.ProductName]; !ok {
+			productOrder = append(productOrder, m.Cycle.ProductName)
+			permalinks[m.Cycle.ProductName] = m.Cycle.ProductPermalink
+		}
+		spans[m.Cycle.ProductName] = append(spans[m.Cycle.ProductName], s)
+	}
+
+	total := end.Sub(start).Hours()
+	position := func(t time.Time) float64 {
+		if total <= 0 {
+			return 0
+		}
+		return t.Sub(start).Hours() / total * 100
+	}
+
+	t := timeline{
+		Start:    start.Format(eol.DateLayout),
+		End:      end.Format(eol.DateLayout),
+		Today:    today.Format(eol.DateLayout),
+		TodayPos: percent(position(today)),
+	}
+
+	for _, name := range productOrder {
+		p := productTimeline{Name: name, Permalink: permalinks[name]}
+
+		productSpans := spans[name]
+		sort.SliceStable(productSpans, func(i, j int) bool {
+			return productSpans[i].start.Before(productSpans[j].start)
+		})
+
+		for _, s := range productSpans {
+			eolDate := s.cycle.Eol
+			switch {
+			case s.cycle.EolBool:
+				eolDate = "YES"
+			case !s.cycle.HasEolDate():
+				eolDate = "-"
+			}
+
+			offset := position(s.start)
+			p.Cycles = append(p.Cycles, cycleBar{
+				ReleaseCycle: s.cycle.ReleaseCycle,
+				ReleaseDate:  s.cycle.ReleaseDate,
+				Eol:          eolDate,
+				Status:       string(s.status),
+				Offset:       percent(offset),
+				Width:        percent(position(s.end) - offset),
+			})
+		}
+		t.Products = append(t.Products, p)
+	}
+
+	return t
+}
+
+func percent(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64) + "%"
+}
+
+func (pres *Presenter) sourceInfo() sourceInfo {
+	src := pres.context.Source
+	if src == nil {
+		return sourceInfo{}
+	}
+
+	info := sourceInfo{
+		Name:    src.Name,
+		Version: src.Version,
+	}
+
+	switch m := src.Metadata.(type) {
+	case syftSource.ImageMetadata:
+		info.Type = "image"
+		info.Target = m.UserInput
+	case syftSource.DirectoryMetadata:
+		info.Type = "directory"
+		info.Target = m.Path
+	case syftSource.FileMetadata:
+		info.Type = "file"
+		info.Target = m.Path
+	}
+	return info
+}
+
+func (pres *Presenter) distroInfo() distroInfo {
+	r := pres.context.Distro
+	if r == nil {
+		return distroInfo{}
+	}
+
+	d, err := distro.NewFromRelease(*r)
+	if err != nil {
+		return distroInfo{Name: r.ID, Version: r.VersionID}
+	}
+	return distroInfo{Name: d.Name(), Version: d.FullVersion()}
+}
@@ -0,0 +1,128 @@
+package html
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/policy/types"
+	"github.com/xeol-io/xeol/xeol/presenter/internal"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+func TestHTMLPresenter(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	pb := models.PresenterConfig{
+		Matches:       matches,
+		Packages:      packages,
+		Context:       context,
+		ShowVulnCount: true,
+	}
+
+	// the Ubuntu 16.04 match is within the lookahead window
+	now = func() time.Time { return time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	pres := NewPresenter(pb)
+
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := buffer.Bytes()
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestEmptyHTMLPresenter(t *testing.T) {
+	var buffer bytes.Buffer
+	_, packages, context, _, _ := internal.GenerateAnalysis(t, internal.DirectorySource)
+
+	pb := models.PresenterConfig{
+		Matches:  match.NewMatches(),
+		Packages: packages,
+		Context:  context,
+	}
+
+	pres := NewPresenter(pb)
+
+	require.NoError(t, pres.Present(&buffer))
+	assert.Contains(t, buffer.String(), "no EOL software has been found")
+}
+
+func TestHTMLPresenterPolicyEvaluations(t *testing.T) {
+	var buffer bytes.Buffer
+
+	pb := models.PresenterConfig{
+		Matches: match.NewMatches(),
+		PolicyEvaluations: []types.PolicyEvaluationResult{
+			types.EolEvaluationResult{
+				Type:           types.PolicyTypeEol,
+				Action:         types.PolicyActionWarn,
+				PolicyID:       "policy-1",
+				ProductName:    "MongoDB Server",
+				Cycle:          "2.8",
+				PackageName:    "mongodb",
+				PackageVersion: "2.8.0",
+				FailDate:       "2018-07-31",
+			},
+		},
+	}
+
+	pres := NewPresenter(pb)
+
+	require.NoError(t, pres.Present(&buffer))
+	assert.Contains(t, buffer.String(), "<h2>Policy evaluations</h2>")
+	assert.Contains(t, buffer.String(), "<td>MongoDB Server 2.8</td>")
+	assert.Contains(t, buffer.String(), ">mongodb@2.8.0</td>")
+	assert.Contains(t, buffer.String(), "<td>2018-07-31</td>")
+}
+
+func TestNewTimeline(t *testing.T) {
+	today := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	matches := []match.Match{
+		{Cycle: eol.Cycle{ProductName: "Node.js", ReleaseCycle: "10", ReleaseDate: "2018-01-01", Eol: "2019-01-01"}},
+		{Cycle: eol.Cycle{ProductName: "Node.js", ReleaseCycle: "12", ReleaseDate: "2019-01-01", Eol: "2021-01-01"}},
+		// the same cycle is only drawn once
+		{Cycle: eol.Cycle{ProductName: "Node.js", ReleaseCycle: "12", ReleaseDate: "2019-01-01", Eol: "2021-01-01"}},
+		{Cycle: eol.Cycle{ProductName: "Python", ReleaseCycle: "2.7", ReleaseDate: "2019-01-01", EolBool: true}},
+		// the store reports a missing EOL date as the zero date
+		{Cycle: eol.Cycle{ProductName: "Python", ReleaseCycle: "3.8", ReleaseDate: "2019-10-14", Eol: "0001-01-01"}},
+	}
+
+	actual := newTimeline(matches, today)
+
+	assert.Equal(t, "2018-01-01", actual.Start)
+	assert.Equal(t, "2021-01-01", actual.End)
+	require.Len(t, actual.Products, 2)
+
+	node := actual.Products[0]
+	require.Len(t, node.Cycles, 2)
+	assert.Equal(t, cycleBar{ReleaseCycle: "10", ReleaseDate: "2018-01-01", Eol: "2019-01-01", Status: string(match.StatusEol), Offset: "0.00%", Width: "33.30%"}, node.Cycles[0])
+	assert.Equal(t, string(match.StatusEolWithinWindow), node.Cycles[1].Status)
+
+	python := actual.Products[1]
+	require.Len(t, python.Cycles, 2)
+	// boolean EOL cycles are drawn up to today
+	assert.Equal(t, "YES", python.Cycles[0].Eol)
+	assert.Equal(t, string(match.StatusEol), python.Cycles[0].Status)
+	// cycles without an EOL date are drawn up to today too
+	assert.Equal(t, "-", python.Cycles[1].Eol)
+	assert.Equal(t, string(match.StatusNoEolData), python.Cycles[1].Status)
+}
+
+func TestNewRowWithoutEolDate(t *testing.T) {
+	m := match.Match{Cycle: eol.Cycle{ProductName: "Python", ReleaseCycle: "3.8", Eol: "0001-01-01"}}
+
+	actual, err := newRow(m, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, "-", actual.Eol)
+	assert.Equal(t, "-", actual.DaysEol)
+	assert.Equal(t, string(match.StatusNoEolData), actual.Status)
+}
@@ -0,0 +1,82 @@
+package html
+
+import "github.com/xeol-io/xeol/xeol/presenter/models"
+
+// report is the data rendered by the HTML report template
+type report struct {
+	Title         string
+	GeneratedAt   string
+	Version       string
+	Source        sourceInfo
+	Distro        distroInfo
+	Summary       summary
+	ShowVulnCount bool
+	Rows          []row
+	Timeline      timeline
+	// PolicyEvaluations are the policies that fired during the scan
+	PolicyEvaluations []models.PolicyEvaluation
+}
+
+type sourceInfo struct {
+	Name    string
+	Version string
+	Type    string
+	Target  string
+}
+
+type distroInfo struct {
+	Name    string
+	Version string
+}
+
+type summary struct {
+	Eol      int
+	EolSoon  int
+	Other    int // supported packages, unknown cycles and cycles without EOL data
+	Packages int
+	Products int
+}
+
+// row is a single match in the report table
+type row struct {
+	Name      string
+	Version   string
+	Type      string
+	Product   string
+	Permalink string
+	Cycle     string
+	Eol       string
+	DaysEol   string
+	// DaysEolSort is used to sort the DAYS EOL column, boolean EOL values sort first
+	DaysEolSort int
+	Status      string
+	StatusLabel string
+	PURL        string
+	Locations   []string
+	VulnCount   int
+}
+
+// timeline places the release → EOL range of every matched cycle on a shared time axis
+type timeline struct {
+	Start    string
+	End      string
+	Today    string
+	TodayPos string
+	Products []productTimeline
+}
+
+type productTimeline struct {
+	Name      string
+	Permalink string
+	Cycles    []cycleBar
+}
+
+type cycleBar struct {
+	ReleaseCycle string
+	ReleaseDate  string
+	Eol          string
+	Status       string
+	// Offset and Width are percentages of the timeline range
+	Offset string
+	Width  string
+}
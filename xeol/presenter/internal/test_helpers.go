@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/linux"
 	syftPkg "github.com/anchore/syft/syft/pkg"
@@ -55,14 +56,18 @@ func SBOMFromPackages(t *testing.T, packages []pkg.Package) *sbom.SBOM {
 }
 
 func toSyftPkg(p pkg.Package) syftPkg.Package {
-	return syftPkg.Package{
+	sp := syftPkg.Package{
 		Name:      p.Name,
 		Version:   p.Version,
 		Type:      p.Type,
 		Metadata:  p.Metadata,
 		Locations: p.Locations,
 		CPEs:      p.CPEs,
+		PURL:      p.PURL,
 	}
+	// keep the syft package ID in sync with the xeol package so matches can be related back to the SBOM
+	sp.OverrideID(artifact.ID(p.ID))
+	return sp
 }
 
 func Redact(s []byte) []byte {
@@ -0,0 +1,294 @@
+package junit
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xeol-io/xeol/internal"
+	"github.com/xeol-io/xeol/internal/version"
+	"github.com/xeol-io/xeol/xeol/distro"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/policy/types"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+const (
+	failureType       = "EndOfLifeSoftware"
+	policyFailureType = "PolicyViolation"
+)
+
+var now = time.Now
+
+// Presenter writes a JUnit XML report in which every scanned package is a test case
+// and every EOL package, or package denied by a policy, is a failing test case
+type Presenter struct {
+	matches           match.Matches
+	packages          []pkg.Package
+	context           pkg.Context
+	policyEvaluations []models.PolicyEvaluation
+	skipPassing       bool
+}
+
+// NewPresenter is a *Presenter constructor. When skipPassing is set, packages that are not
+// EOL (including packages that are only EOL within the lookahead window) are reported as
+// skipped test cases instead of passing ones.
+func NewPresenter(pb models.PresenterConfig, skipPassing bool) *Presenter {
+	return &Presenter{
+		matches:           pb.Matches,
+		packages:          pb.Packages,
+		context:           pb.Context,
+		policyEvaluations: models.NewPolicyEvaluations(pb.PolicyEvaluations),
+		skipPassing:       skipPassing,
+	}
+}
+
+// Present creates a JUnit XML report
+func (pres *Presenter) Present(output io.Writer) error {
+	suite, err := pres.testSuite()
+	if err != nil {
+		return err
+	}
+
+	doc := testSuites{
+		Name:     internal.ApplicationName,
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Skipped:  suite.Skipped,
+		Suites:   []testSuite{suite},
+	}
+
+	if _, err := io.WriteString(output, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(output)
+	enc.Indent("", " ")
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	_, err = io.WriteString(output, "\n")
+	return err
+}
+
+func (pres *Presenter) testSuite() (testSuite, error) {
+	matchesByPackage := make(map[pkg.ID][]match.Match)
+	for _, m := range pres.matches.Sorted() {
+		matchesByPackage[m.Package.ID] = append(matchesByPackage[m.Package.ID], m)
+	}
+	evaluationsByPackage := make(map[pkg.ID][]models.PolicyEvaluation)
+	for _, e := range pres.policyEvaluations {
+		if e.PackageID != "" {
+			evaluationsByPackage[pkg.ID(e.PackageID)] = append(evaluationsByPackage[pkg.ID(e.PackageID)], e)
+		}
+	}
+
+	suite := testSuite{
+		Name:       pres.suiteName(),
+		Timestamp:  now().UTC().Format("2006-01-02T15:04:05"),
+		Properties: pres.properties(),
+	}
+
+	for _, p := range pres.allPackages() {
+		tc, err := pres.testCase(p, matchesByPackage[p.ID], evaluationsByPackage[p.ID])
+		if err != nil {
+			return testSuite{}, err
+		}
+
+		suite.Tests++
+		switch {
+		case tc.Failure != nil:
+			suite.Failures++
+		case tc.Skipped != nil:
+			suite.Skipped++
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+
+	return suite, nil
+}
+
+// allPackages returns the scanned packages along with the packages that only appear in matches
+// (e.g. the distro of the scanned source), sorted by type, name and version
+func (pres *Presenter) allPackages() []pkg.Package {
+	seen := make(map[pkg.ID]struct{})
+	var packages []pkg.Package
+	for _, p := range pres.packages {
+		if _, ok := seen[p.ID]; ok {
+			continue
+		}
+		seen[p.ID] = struct{}{}
+		packages = append(packages, p)
+	}
+
+	for _, m := range pres.matches.Sorted() {
+		if _, ok := seen[m.Package.ID]; ok {
+			continue
+		}
+		seen[m.Package.ID] = struct{}{}
+		packages = append(packages, m.Package)
+	}
+
+	sort.SliceStable(packages, func(i, j int) bool {
+		if packages[i].Type != packages[j].Type {
+			return packages[i].Type < packages[j].Type
+		}
+		if packages[i].Name != packages[j].Name {
+			return packages[i].Name < packages[j].Name
+		}
+		return packages[i].Version < packages[j].Version
+	})
+	return packages
+}
+
+func (pres *Presenter) testCase(p pkg.Package, matches []match.Match, evaluations []models.PolicyEvaluation) (testCase, error) {
+	tc := testCase{
+		Name:      fmt.Sprintf("%s@%s", p.Name, p.Version),
+		ClassName: string(p.Type),
+	}
+
+	var eolMessages, lookaheadMessages, otherMessages []string
+	for _, m := range matches {
+		switch m.StatusAt(now()) {
+		case match.StatusEol:
+			if m.Cycle.EolBool {
+				eolMessages = append(eolMessages, fmt.Sprintf("%s %s is end-of-life", m.Cycle.ProductName, m.Cycle.ReleaseCycle))
+				continue
+			}
+			days, err := m.Cycle.DaysEol(now())
+			if err != nil {
+				return testCase{}, fmt.Errorf("unable to parse EOL date for package %s: %w", p.PURL, err)
+			}
+			eolMessages = append(eolMessages, fmt.Sprintf("%s %s has been end-of-life since %s (%d days)", m.Cycle.ProductName, m.Cycle.ReleaseCycle, m.Cycle.Eol, days))
+		case match.StatusEolWithinWindow:
+			days, err := m.Cycle.DaysEol(now())
+			if err != nil {
+				return testCase{}, fmt.Errorf("unable to parse EOL date for package %s: %w", p.PURL, err)
+			}
+			lookaheadMessages = append(lookaheadMessages, fmt.Sprintf("%s %s will be end-of-life on %s (in %d days)", m.Cycle.ProductName, m.Cycle.ReleaseCycle, m.Cycle.Eol, -days))
+		case match.StatusSupported:
+			otherMessages = append(otherMessages, fmt.Sprintf("%s %s is supported until %s", m.Cycle.ProductName, m.Cycle.ReleaseCycle, m.Cycle.Eol))
+		case match.StatusNoEolData:
+			otherMessages = append(otherMessages, fmt.Sprintf("%s %s has no end-of-life date", m.Cycle.ProductName, m.Cycle.ReleaseCycle))
+		case match.StatusUnknownCycle:
+			otherMessages = append(otherMessages, fmt.Sprintf("%s %s does not match any known %s cycle", p.Name, p.Version, m.Cycle.ProductName))
+		}
+	}
+
+	var denyMessages, warnMessages []string
+	for _, e := range evaluations {
+		if e.Action == string(types.PolicyActionDeny) {
+			denyMessages = append(denyMessages, policyMessage(e))
+		} else {
+			warnMessages = append(warnMessages, policyMessage(e))
+		}
+	}
+
+	// packages that are not EOL yet, nor denied by a policy, only get informational messages
+	infoMessages := append(append(warnMessages, lookaheadMessages...), otherMessages...)
+
+	switch {
+	case len(eolMessages) > 0:
+		tc.Failure = &failure{
+			Message: fmt.Sprintf("%s %s is end-of-life", p.Name, p.Version),
+			Type:    failureType,
+			Text:    failureText(p, matches, append(append(eolMessages, denyMessages...), warnMessages...)),
+		}
+	case len(denyMessages) > 0:
+		tc.Failure = &failure{
+			Message: fmt.Sprintf("%s %s is denied by a policy", p.Name, p.Version),
+			Type:    policyFailureType,
+			Text:    failureText(p, matches, append(denyMessages, infoMessages...)),
+		}
+	case pres.skipPassing && len(infoMessages) > 0:
+		tc.Skipped = &skipped{Message: strings.Join(infoMessages, "; ")}
+	case pres.skipPassing:
+		tc.Skipped = &skipped{Message: "not end-of-life"}
+	case len(infoMessages) > 0:
+		tc.SystemOut = strings.Join(infoMessages, "\n")
+	}
+
+	return tc, nil
+}
+
+// policyMessage describes a policy that fired, e.g. "DENY EOL policy policy-1 (software) fired for MongoDB Server 2.8"
+func policyMessage(e models.PolicyEvaluation) string {
+	msg := fmt.Sprintf("%s %s policy", e.Action, e.Type)
+	if e.PolicyID != "" {
+		msg += " " + e.PolicyID
+	}
+	if e.PolicyScope != "" {
+		msg += fmt.Sprintf(" (%s)", e.PolicyScope)
+	}
+	if target := e.Target(); target != "" {
+		msg += " fired for " + target
+	}
+	if e.FailDate != "" {
+		msg += fmt.Sprintf(", denying scans from %s", e.FailDate)
+	}
+	return msg
+}
+
+func failureText(p pkg.Package, matches []match.Match, messages []string) string {
+	lines := append([]string{}, messages...)
+	if p.PURL != "" {
+		lines = append(lines, fmt.Sprintf("PURL: %s", p.PURL))
+	}
+	for _, l := range p.Locations.ToSlice() {
+		lines = append(lines, fmt.Sprintf("Location: %s", l.RealPath))
+	}
+	for _, m := range matches {
+		if m.Cycle.LatestRelease != "" {
+			lines = append(lines, fmt.Sprintf("Latest %s release: %s", m.Cycle.ProductName, m.Cycle.LatestRelease))
+		}
+		if m.Cycle.ProductPermalink != "" {
+			lines = append(lines, fmt.Sprintf("More information: %s", m.Cycle.ProductPermalink))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (pres *Presenter) suiteName() string {
+	if pres.context.Source != nil && pres.context.Source.Name != "" {
+		return pres.context.Source.Name
+	}
+	return internal.ApplicationName
+}
+
+func (pres *Presenter) properties() []property {
+	props := []property{
+		{Name: "xeol.version", Value: version.FromBuild().Version},
+	}
+
+	if src := pres.context.Source; src != nil {
+		if src.Name != "" {
+			props = append(props, property{Name: "source.name", Value: src.Name})
+		}
+		if src.Version != "" {
+			props = append(props, property{Name: "source.version", Value: src.Version})
+		}
+	}
+
+	// policies that did not fire for a package (e.g. notary policies) are reported on the suite
+	for _, e := range pres.policyEvaluations {
+		if e.PackageID == "" {
+			props = append(props, property{Name: "policy", Value: policyMessage(e)})
+		}
+	}
+
+	if r := pres.context.Distro; r != nil {
+		name, version := r.ID, r.VersionID
+		if d, err := distro.NewFromRelease(*r); err == nil {
+			name, version = d.Name(), d.FullVersion()
+		}
+		props = append(props,
+			property{Name: "distro.name", Value: name},
+			property{Name: "distro.version", Value: version},
+		)
+	}
+	return props
+}
@@ -0,0 +1,144 @@
+package junit
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	syftPkg "github.com/anchore/syft/syft/pkg"
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/presenter/internal"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+func presenterConfig(t *testing.T) models.PresenterConfig {
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	// a package without any EOL finding
+	packages = append(packages, pkg.Package{
+		ID:      "package-3-id",
+		Name:    "package-3",
+		Version: "3.3.3",
+		Type:    syftPkg.NpmPkg,
+	})
+
+	return models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+	}
+}
+
+func TestJUnitPresenter(t *testing.T) {
+	cases := []struct {
+		name        string
+		skipPassing bool
+	}{
+		{
+			name:        "passing",
+			skipPassing: false,
+		},
+		{
+			name:        "skip passing",
+			skipPassing: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			// the Ubuntu 16.04 match is within the lookahead window
+			now = func() time.Time { return time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+			pres := NewPresenter(presenterConfig(t), tc.skipPassing)
+
+			if err := pres.Present(&buffer); err != nil {
+				t.Fatal(err)
+			}
+			actual := buffer.Bytes()
+
+			snaps.MatchSnapshot(t, actual)
+		})
+	}
+}
+
+func TestTestCase(t *testing.T) {
+	now = func() time.Time { return time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC) }
+	p := pkg.Package{Name: "node", Version: "14.0.0", Type: syftPkg.NpmPkg}
+
+	cases := []struct {
+		name        string
+		matches     []match.Match
+		evaluations []models.PolicyEvaluation
+		skipPassing bool
+		failed      bool
+		skipped     bool
+		failureText string
+	}{
+		{
+			name:    "no match",
+			matches: nil,
+		},
+		{
+			name:        "no match skipped",
+			matches:     nil,
+			skipPassing: true,
+			skipped:     true,
+		},
+		{
+			name:    "eol date in the past",
+			matches: []match.Match{{Package: p, Cycle: eol.Cycle{ProductName: "Node.js", ReleaseCycle: "14", Eol: "2020-01-01"}}},
+			failed:  true,
+		},
+		{
+			name:    "eol bool",
+			matches: []match.Match{{Package: p, Cycle: eol.Cycle{ProductName: "Node.js", ReleaseCycle: "14", EolBool: true}}},
+			failed:  true,
+		},
+		{
+			name:    "lookahead only",
+			matches: []match.Match{{Package: p, Cycle: eol.Cycle{ProductName: "Node.js", ReleaseCycle: "14", Eol: "2021-06-01"}}},
+		},
+		{
+			name:        "lookahead only skipped",
+			matches:     []match.Match{{Package: p, Cycle: eol.Cycle{ProductName: "Node.js", ReleaseCycle: "14", Eol: "2021-06-01"}}},
+			skipPassing: true,
+			skipped:     true,
+		},
+		{
+			name:        "denied by a policy",
+			matches:     []match.Match{{Package: p, Cycle: eol.Cycle{ProductName: "Node.js", ReleaseCycle: "14", Eol: "2021-06-01"}}},
+			evaluations: []models.PolicyEvaluation{{Type: "EOL", Action: "DENY", PolicyID: "policy-1", PolicyScope: "software", ProductName: "Node.js", Cycle: "14"}},
+			failed:      true,
+			failureText: "DENY EOL policy policy-1 (software) fired for Node.js 14\nNode.js 14 will be end-of-life on 2021-06-01 (in 152 days)",
+		},
+		{
+			name:        "warned by a policy",
+			matches:     []match.Match{{Package: p, Cycle: eol.Cycle{ProductName: "Node.js", ReleaseCycle: "14", Eol: "2020-01-01"}}},
+			evaluations: []models.PolicyEvaluation{{Type: "EOL", Action: "WARN", ProductName: "Node.js", Cycle: "14", FailDate: "2021-01-01"}},
+			failed:      true,
+			failureText: "Node.js 14 has been end-of-life since 2020-01-01 (365 days)\nWARN EOL policy fired for Node.js 14, denying scans from 2021-01-01",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pres := &Presenter{skipPassing: tc.skipPassing}
+			actual, err := pres.testCase(p, tc.matches, tc.evaluations)
+			require.NoError(t, err)
+
+			assert.Equal(t, "node@14.0.0", actual.Name)
+			assert.Equal(t, tc.failed, actual.Failure != nil)
+			assert.Equal(t, tc.skipped, actual.Skipped != nil)
+			if tc.failureText != "" {
+				assert.Equal(t, tc.failureText, actual.Failure.Text)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package markdown
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/policy/types"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+var now = time.Now
+
+// Presenter is a generic struct for holding fields needed for reporting
+type Presenter struct {
+	matches           match.Matches
+	policyEvaluations []types.PolicyEvaluationResult
+	showVulnCount     bool
+}
+
+// product groups all matches of a single product
+type product struct {
+	name      string
+	permalink string
+	matches   []match.Match
+}
+
+// NewPresenter is a *Presenter constructor
+func NewPresenter(pb models.PresenterConfig) *Presenter {
+	return &Presenter{
+		matches:           pb.Matches,
+		policyEvaluations: pb.PolicyEvaluations,
+		showVulnCount:     pb.ShowVulnCount,
+	}
+}
+
+// Present creates a GitHub-flavored markdown report
+func (pres *Presenter) Present(output io.Writer) error {
+	var matches []match.Match
+	for _, m := range pres.matches.Sorted() {
+		if m.Package.Name == "" {
+			continue
+		}
+		matches = append(matches, m)
+	}
+
+	var sb strings.Builder
+	if len(matches) == 0 {
+		sb.WriteString("✅ no EOL software has been found\n")
+		pres.writePolicyEvaluations(&sb)
+		_, err := io.WriteString(output, sb.String())
+		return err
+	}
+
+	sb.WriteString("## xeol EOL report\n\n")
+
+	sb.WriteString(summarize(matches))
+	sb.WriteString("\n")
+
+	for _, p := range groupByProduct(matches) {
+		if err := pres.writeProduct(&sb, p); err != nil {
+			return err
+		}
+	}
+	pres.writePolicyEvaluations(&sb)
+
+	_, err := io.WriteString(output, sb.String())
+	return err
+}
+
+// writePolicyEvaluations writes a table of the policies that fired, if any
+func (pres *Presenter) writePolicyEvaluations(sb *strings.Builder) {
+	evaluations := models.NewPolicyEvaluations(pres.policyEvaluations)
+	if len(evaluations) == 0 {
+		return
+	}
+
+	sb.WriteString("\n### Policy evaluations\n\n")
+	writeRow(sb, []string{"ACTION", "TYPE", "POLICY", "SCOPE", "TARGET", "PACKAGE", "FAIL DATE"})
+	writeRow(sb, []string{"---", "---", "---", "---", "---", "---", "---"})
+	for _, e := range evaluations {
+		writeRow(sb, []string{e.Action, e.Type, orDash(e.PolicyID), orDash(e.PolicyScope), orDash(escape(e.Target())), orDash(escape(e.Package())), orDash(e.FailDate)})
+	}
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func (pres *Presenter) writeProduct(sb *strings.Builder, p product) error {
+	title := p.name
+	if p.permalink != "" {
+		title = fmt.Sprintf(`<a href="%s">%s</a>`, p.permalink, p.name)
+	}
+	fmt.Fprintf(sb, "<details>\n<summary>%s (%d)</summary>\n\n", title, len(p.matches))
+
+	columns := []string{"NAME", "VERSION", "CYCLE", "EOL", "DAYS EOL", "STATUS", "TYPE"}
+	if pres.showVulnCount {
+		columns = append(columns, "# OF VULNS.")
+	}
+	writeRow(sb, columns)
+
+	separators := make([]string, len(columns))
+	for i := range separators {
+		separators[i] = "---"
+	}
+	writeRow(sb, separators)
+
+	for _, m := range p.matches {
+		row, err := createRow(m, pres.showVulnCount)
+		if err != nil {
+			return err
+		}
+		writeRow(sb, row)
+	}
+
+	sb.WriteString("\n</details>\n\n")
+	return nil
+}
+
+func createRow(m match.Match, showVulnCount bool) ([]string, error) {
+	row := []string{escape(m.Package.Name), escape(m.Package.Version), escape(m.Cycle.ReleaseCycle)}
+	switch {
+	case m.Cycle.EolBool:
+		row = append(row, "YES", "-")
+	case !m.Cycle.HasEolDate():
+		row = append(row, "-", "-")
+	default:
+		daysEol, err := m.Cycle.DaysEol(now())
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse EOL date for package %s: %w", m.Package.PURL, err)
+		}
+		days := "-"
+		if daysEol >= 0 {
+			days = strconv.Itoa(daysEol)
+		}
+		row = append(row, m.Cycle.Eol, days)
+	}
+	row = append(row, m.StatusLabel(now()), string(m.Package.Type))
+
+	if showVulnCount {
+		row = append(row, strconv.Itoa(m.VulnCount))
+	}
+	return row, nil
+}
+
+// summarize returns a line with the count of EOL and soon-to-be EOL packages, by package type, along with the count
+// of any other reported packages
+func summarize(matches []match.Match) string {
+	eolByType := make(map[string]int)
+	soonByType := make(map[string]int)
+	var eolCount, soonCount, otherCount int
+
+	for _, m := range matches {
+		t := string(m.Package.Type)
+		switch m.StatusAt(now()) {
+		case match.StatusEol:
+			eolByType[t]++
+			eolCount++
+		case match.StatusEolWithinWindow:
+			soonByType[t]++
+			soonCount++
+		default:
+			otherCount++
+		}
+	}
+
+	summary := fmt.Sprintf("**%d** EOL %s%s, **%d** %s will be EOL soon%s",
+		eolCount, plural(eolCount), countsByType(eolByType),
+		soonCount, plural(soonCount), countsByType(soonByType),
+	)
+	if otherCount > 0 {
+		summary += fmt.Sprintf(", **%d** %s not EOL", otherCount, plural(otherCount))
+	}
+	return summary + "\n"
+}
+
+func countsByType(counts map[string]int) string {
+	if len(counts) == 0 {
+		return ""
+	}
+
+	types := make([]string, 0, len(counts))
+	for t := range counts {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	parts := make([]string, 0, len(types))
+	for _, t := range types {
+		parts = append(parts, fmt.Sprintf("%s: %d", t, counts[t]))
+	}
+	return fmt.Sprintf(" (%s)", strings.Join(parts, ", "))
+}
+
+func plural(count int) string {
+	if count == 1 {
+		return "package"
+	}
+	return "packages"
+}
+
+// groupByProduct groups the (already sorted) matches by product name, keeping the order of the matches
+func groupByProduct(matches []match.Match) []product {
+	var products []product
+	for _, m := range matches {
+		if len(products) == 0 || products[len(products)-1].name != m.Cycle.ProductName {
+			products = append(products, product{
+				name:      m.Cycle.ProductName,
+				permalink: m.Cycle.ProductPermalink,
+			})
+		}
+		products[len(products)-1].matches = append(products[len(products)-1].matches, m)
+	}
+	return products
+}
+
+func writeRow(sb *strings.Builder, row []string) {
+	sb.WriteString("| ")
+	sb.WriteString(strings.Join(row, " | "))
+	sb.WriteString(" |\n")
+}
+
+// escape prevents values from breaking the markdown table layout
+func escape(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
@@ -0,0 +1,104 @@
+package markdown
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/policy/types"
+	"github.com/xeol-io/xeol/xeol/presenter/internal"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+func TestSummarize(t *testing.T) {
+	matches, _, _, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	now = func() time.Time { return time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	summary := summarize(matches.Sorted())
+	assert.Equal(t, "**2** EOL packages (deb: 1, rpm: 1), **1** package will be EOL soon (os: 1)\n", summary)
+}
+
+func TestMarkdownPresenter(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, _, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	pb := models.PresenterConfig{
+		Matches:       matches,
+		Packages:      packages,
+		ShowVulnCount: true,
+	}
+
+	now = func() time.Time { return time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	pres := NewPresenter(pb)
+
+	// run presenter
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := buffer.Bytes()
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestEmptyMarkdownPresenter(t *testing.T) {
+	var buffer bytes.Buffer
+
+	pb := models.PresenterConfig{
+		Matches: match.NewMatches(),
+	}
+
+	pres := NewPresenter(pb)
+
+	// run presenter
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := buffer.Bytes()
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestMarkdownPresenterPolicyEvaluations(t *testing.T) {
+	var buffer bytes.Buffer
+
+	pb := models.PresenterConfig{
+		Matches: match.NewMatches(),
+		PolicyEvaluations: []types.PolicyEvaluationResult{
+			types.EolEvaluationResult{
+				Type:           types.PolicyTypeEol,
+				Action:         types.PolicyActionDeny,
+				PolicyID:       "policy-1",
+				PolicyScope:    "software",
+				ProductName:    "MongoDB Server",
+				Cycle:          "2.8",
+				PackageName:    "mongodb",
+				PackageVersion: "2.8.0",
+			},
+			types.EolEvaluationResult{
+				Type:           types.PolicyTypeEol,
+				Action:         types.PolicyActionWarn,
+				ProductName:    "Python",
+				Cycle:          "3.7",
+				PackageName:    "python",
+				PackageVersion: "3.7.17",
+				FailDate:       "2018-07-31",
+			},
+		},
+	}
+
+	pres := NewPresenter(pb)
+
+	// run presenter
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := buffer.Bytes()
+
+	snaps.MatchSnapshot(t, actual)
+}
@@ -18,7 +18,7 @@ type Cycle struct {
 
 func NewCycle(c eol.Cycle) Cycle {
 	var eol string
-	if c.Eol == "0001-01-01" || len(c.Eol) < 1 {
+	if !c.HasEolDate() {
 		eol = fmt.Sprintf("%t", c.EolBool)
 	} else {
 		eol = c.Eol
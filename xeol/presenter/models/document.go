@@ -7,35 +7,38 @@ import (
 	"github.com/xeol-io/xeol/internal/version"
 	"github.com/xeol-io/xeol/xeol/match"
 	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/policy/types"
 )
 
 // Document represents the JSON document to be presented
 type Document struct {
-	Matches    []Match      `json:"matches"`
-	Source     *source      `json:"source"`
-	Distro     distribution `json:"distro"`
-	Descriptor descriptor   `json:"descriptor"`
+	Matches           []Match            `json:"matches"`
+	IgnoredMatches    []IgnoredMatch     `json:"ignoredMatches,omitempty"`
+	PolicyEvaluations []PolicyEvaluation `json:"policyEvaluations,omitempty"`
+	Source            *source            `json:"source"`
+	Distro            distribution       `json:"distro"`
+	Descriptor        descriptor         `json:"descriptor"`
 }
 
 // NewDocument creates and populates a new Document struct, representing the populated JSON document.
-func NewDocument(packages []pkg.Package, context pkg.Context, matches match.Matches, appConfig interface{}, dbStatus interface{}) (Document, error) {
+func NewDocument(packages []pkg.Package, context pkg.Context, matches match.Matches, ignoredMatches []match.IgnoredMatch, policyEvaluations []types.PolicyEvaluationResult, appConfig interface{}, dbStatus interface{}) (Document, error) {
 	// we must preallocate the findings to ensure the JSON document does not show "null" when no matches are found
 	var findings = make([]Match, 0)
 	for _, m := range matches.Sorted() {
-		// syft doesn't treat OS packages as real "packages", so they won't exist in the
-		// packages collection. we need to handle this case separately.
-		if m.Package.Type == "os" {
-			matchModel := newMatch(m, m.Package)
-			findings = append(findings, *matchModel)
-		} else {
-			p := pkg.ByID(m.Package.ID, packages)
-			if p == nil {
-				return Document{}, fmt.Errorf("unable to find package in collection: %+v", p)
-			}
-
-			matchModel := newMatch(m, *p)
-			findings = append(findings, *matchModel)
+		matchModel, err := newDocumentMatch(m, packages)
+		if err != nil {
+			return Document{}, err
+		}
+		findings = append(findings, *matchModel)
+	}
+
+	var ignored []IgnoredMatch
+	for _, m := range ignoredMatches {
+		matchModel, err := newDocumentMatch(m.Match, packages)
+		if err != nil {
+			return Document{}, err
 		}
+		ignored = append(ignored, newIgnoredMatch(*matchModel, m.AppliedIgnoreRules))
 	}
 
 	var src *source
@@ -48,9 +51,11 @@ func NewDocument(packages []pkg.Package, context pkg.Context, matches match.Matc
 	}
 
 	return Document{
-		Matches: findings,
-		Source:  src,
-		Distro:  newDistribution(context.Distro),
+		Matches:           findings,
+		IgnoredMatches:    ignored,
+		PolicyEvaluations: NewPolicyEvaluations(policyEvaluations),
+		Source:            src,
+		Distro:            newDistribution(context.Distro),
 		Descriptor: descriptor{
 			Name:          internal.ApplicationName,
 			Version:       version.FromBuild().Version,
@@ -59,3 +64,17 @@ func NewDocument(packages []pkg.Package, context pkg.Context, matches match.Matc
 		},
 	}, nil
 }
+
+func newDocumentMatch(m match.Match, packages []pkg.Package) (*Match, error) {
+	// syft doesn't treat OS packages as real "packages", so they won't exist in the
+	// packages collection. we need to handle this case separately.
+	if m.Package.Type == "os" {
+		return newMatch(m, m.Package), nil
+	}
+
+	p := pkg.ByID(m.Package.ID, packages)
+	if p == nil {
+		return nil, fmt.Errorf("unable to find package in collection: %+v", p)
+	}
+	return newMatch(m, *p), nil
+}
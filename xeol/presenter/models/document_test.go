@@ -11,6 +11,7 @@ import (
 	"github.com/xeol-io/xeol/xeol/eol"
 	"github.com/xeol-io/xeol/xeol/match"
 	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/policy/types"
 )
 
 func TestPackagesAreSorted(t *testing.T) {
@@ -77,7 +78,7 @@ func TestPackagesAreSorted(t *testing.T) {
 			Version: "8.0",
 		},
 	}
-	doc, err := NewDocument(packages, ctx, matches, nil, nil)
+	doc, err := NewDocument(packages, ctx, matches, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("unable to get document: %+v", err)
 	}
@@ -89,3 +90,85 @@ func TestPackagesAreSorted(t *testing.T) {
 
 	assert.Equal(t, []string{"2.8", "3.2", "3.4"}, actualEols)
 }
+
+func TestIgnoredMatches(t *testing.T) {
+	p := pkg.Package{
+		ID:      "package-1-id",
+		Name:    "package-1",
+		Version: "1.1.1",
+		Type:    syftPkg.DebPkg,
+	}
+	m := match.Match{
+		Cycle: eol.Cycle{
+			ProductName:  "MongoDB Server",
+			ReleaseCycle: "2.8",
+			Eol:          "2018-07-31",
+		},
+		Package: p,
+	}
+	rule := match.IgnoreRule{Product: "MongoDB Server", Reason: "replaced in the next release", Until: "2030-01-01"}
+
+	doc, err := NewDocument([]pkg.Package{p}, pkg.Context{}, match.NewMatches(), []match.IgnoredMatch{
+		{Match: m, AppliedIgnoreRules: []match.IgnoreRule{rule}},
+	}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("unable to get document: %+v", err)
+	}
+
+	assert.Empty(t, doc.Matches)
+	if assert.Len(t, doc.IgnoredMatches, 1) {
+		assert.Equal(t, "2.8", doc.IgnoredMatches[0].Cycle.ReleaseCycle)
+		assert.Equal(t, []IgnoreRule{{
+			Product: "MongoDB Server",
+			Reason:  "replaced in the next release",
+			Until:   "2030-01-01",
+		}}, doc.IgnoredMatches[0].AppliedIgnoreRules)
+	}
+}
+
+func TestPolicyEvaluations(t *testing.T) {
+	doc, err := NewDocument(nil, pkg.Context{}, match.NewMatches(), nil, []types.PolicyEvaluationResult{
+		types.EolEvaluationResult{
+			Type:           types.PolicyTypeEol,
+			Action:         types.PolicyActionDeny,
+			PolicyID:       "policy-1",
+			PolicyScope:    "software",
+			ProductName:    "MongoDB Server",
+			Cycle:          "2.8",
+			PackageID:      "mongodb-id",
+			PackageName:    "mongodb",
+			PackageVersion: "2.8.0",
+			PURL:           "pkg:generic/mongodb@2.8.0",
+		},
+		types.NotaryEvaluationResult{
+			Type:           types.PolicyTypeNotary,
+			Action:         types.PolicyActionAllow,
+			ImageReference: "docker.io/library/alpine:3.18",
+			Verified:       true,
+		},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("unable to get document: %+v", err)
+	}
+
+	assert.Equal(t, []PolicyEvaluation{
+		{
+			Type:           "EOL",
+			Action:         "DENY",
+			PolicyID:       "policy-1",
+			PolicyScope:    "software",
+			ProductName:    "MongoDB Server",
+			Cycle:          "2.8",
+			PackageID:      "mongodb-id",
+			PackageName:    "mongodb",
+			PackageVersion: "2.8.0",
+			PURL:           "pkg:generic/mongodb@2.8.0",
+		},
+		{
+			Type:           "NOTARY",
+			Action:         "ALLOW",
+			ImageReference: "docker.io/library/alpine:3.18",
+			Verified:       true,
+		},
+	}, doc.PolicyEvaluations)
+}
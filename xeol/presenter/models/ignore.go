@@ -0,0 +1,31 @@
+package models
+
+import "github.com/xeol-io/xeol/xeol/match"
+
+// IgnoredMatch is a match waived by ignore rules, along with the rules that waived it
+type IgnoredMatch struct {
+	Match
+	AppliedIgnoreRules []IgnoreRule `json:"appliedIgnoreRules"`
+}
+
+// IgnoreRule is an ignore rule and its justification
+type IgnoreRule struct {
+	Product  string `json:"product,omitempty"`
+	Cycle    string `json:"cycle,omitempty"`
+	Purl     string `json:"purl,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Location string `json:"location,omitempty"`
+	Reason   string `json:"reason"`
+	Until    string `json:"until,omitempty"`
+}
+
+func newIgnoredMatch(m Match, rules []match.IgnoreRule) IgnoredMatch {
+	applied := make([]IgnoreRule, 0, len(rules))
+	for _, r := range rules {
+		applied = append(applied, IgnoreRule(r))
+	}
+	return IgnoredMatch{
+		Match:              m,
+		AppliedIgnoreRules: applied,
+	}
+}
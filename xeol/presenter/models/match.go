@@ -9,9 +9,13 @@ import (
 
 // Match is a single item for the JSON array reported
 type Match struct {
-	Cycle    Cycle
-	Package  pkg.Package // The package used to search for a match.
-	Artifact Package     `json:"artifact"`
+	Cycle        Cycle
+	Package      pkg.Package      // The package used to search for a match.
+	Artifact     Package          `json:"artifact"`
+	Upstream     *UpstreamPackage `json:"upstream,omitempty"` // The upstream package that produced the match, if any.
+	MatchDetails []MatchDetails   `json:"matchDetails,omitempty"`
+	Status       string           `json:"status,omitempty"` // The lifecycle status of the cycle.
+	Window       string           `json:"window,omitempty"` // The window the cycle becomes EOL in, for the eol-within-window status.
 }
 
 // MatchDetails contains all data that indicates how the result match was found
@@ -22,10 +26,37 @@ type MatchDetails struct {
 	Found      interface{} `json:"found"`
 }
 
+// NewMatch creates a Match model for the package the match was found for
+func NewMatch(m match.Match) Match {
+	return *newMatch(m, m.Package)
+}
+
 func newMatch(m match.Match, p pkg.Package) *Match {
+	var upstream *UpstreamPackage
+	if m.Upstream != nil {
+		upstream = &UpstreamPackage{
+			Name:    m.Upstream.Name,
+			Version: m.Upstream.Version,
+		}
+	}
+
+	var details []MatchDetails
+	for _, d := range m.Details {
+		details = append(details, MatchDetails{
+			Type:       string(d.Type),
+			Matcher:    string(d.Matcher),
+			SearchedBy: d.SearchedBy,
+			Found:      d.Found,
+		})
+	}
+
 	return &Match{
-		Cycle:    NewCycle(m.Cycle),
-		Artifact: newPackage(p),
+		Cycle:        NewCycle(m.Cycle),
+		Artifact:     newPackage(p),
+		Upstream:     upstream,
+		MatchDetails: details,
+		Status:       string(m.Status),
+		Window:       m.Window,
 	}
 }
 
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	syftPkg "github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+)
+
+func TestNewMatchDetails(t *testing.T) {
+	m := match.Match{
+		Cycle: eol.Cycle{
+			ProductName:  "MongoDB Server",
+			ReleaseCycle: "3.2",
+			Eol:          "2018-07-31",
+		},
+		Package: pkg.Package{
+			Name:    "mongodb-org-server",
+			Version: "3.2.21",
+			Type:    syftPkg.DebPkg,
+			PURL:    "pkg:deb/debian/mongodb-org-server@3.2.21",
+		},
+		Method: match.PURLMethod,
+		Details: match.Details{{
+			Type:       match.PURLMethod,
+			Matcher:    match.PackageMatcher,
+			SearchedBy: map[string]string{"query": "pkg:deb/debian/mongodb-org-server"},
+			Found:      map[string]string{"cycle": "3.2"},
+		}},
+	}
+
+	actual := NewMatch(m)
+	assert.Equal(t, []MatchDetails{{
+		Type:       "purl",
+		Matcher:    "package-matcher",
+		SearchedBy: map[string]string{"query": "pkg:deb/debian/mongodb-org-server"},
+		Found:      map[string]string{"cycle": "3.2"},
+	}}, actual.MatchDetails)
+
+	// matches without details do not report any
+	m.Details = nil
+	b, err := json.Marshal(NewMatch(m))
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "matchDetails")
+}
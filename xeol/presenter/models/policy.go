@@ -0,0 +1,69 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/xeol-io/xeol/xeol/policy/types"
+)
+
+// PolicyEvaluation is the result of a policy that fired during the scan
+type PolicyEvaluation struct {
+	Type           string `json:"type"`
+	Action         string `json:"action"`                   // WARN, DENY or ALLOW
+	PolicyID       string `json:"policyId,omitempty"`       // The policy that fired, if it has an ID.
+	PolicyScope    string `json:"policyScope,omitempty"`    // The scope of the policy that fired: global, project or software.
+	ProductName    string `json:"productName,omitempty"`    // The product the policy fired for (EOL policies).
+	Cycle          string `json:"cycle,omitempty"`          // The cycle the policy fired for (EOL policies).
+	PackageID      string `json:"packageId,omitempty"`      // The package the policy fired for (EOL policies).
+	PackageName    string `json:"packageName,omitempty"`    // The name of the package the policy fired for (EOL policies).
+	PackageVersion string `json:"packageVersion,omitempty"` // The version of the package the policy fired for (EOL policies).
+	PURL           string `json:"purl,omitempty"`           // The PURL of the package the policy fired for (EOL policies).
+	ImageReference string `json:"imageReference,omitempty"` // The image the policy was evaluated against (notary policies).
+	Verified       bool   `json:"verified,omitempty"`       // Whether the image signature was verified (notary policies).
+	FailDate       string `json:"failDate,omitempty"`       // The date a warning policy starts failing scans on.
+}
+
+// NewPolicyEvaluations creates the models of the policy evaluation results
+func NewPolicyEvaluations(results []types.PolicyEvaluationResult) []PolicyEvaluation {
+	var evaluations []PolicyEvaluation
+	for _, r := range results {
+		evaluation := PolicyEvaluation{
+			Type:     string(r.GetPolicyType()),
+			Action:   string(r.GetPolicyAction()),
+			Verified: r.GetVerified(),
+			FailDate: r.GetFailDate(),
+		}
+		switch res := r.(type) {
+		case types.EolEvaluationResult:
+			evaluation.PolicyID = res.PolicyID
+			evaluation.PolicyScope = res.PolicyScope
+			evaluation.ProductName = res.ProductName
+			evaluation.Cycle = res.Cycle
+			evaluation.PackageID = res.PackageID
+			evaluation.PackageName = res.PackageName
+			evaluation.PackageVersion = res.PackageVersion
+			evaluation.PURL = res.PURL
+		case types.NotaryEvaluationResult:
+			evaluation.ImageReference = res.ImageReference
+		}
+		evaluations = append(evaluations, evaluation)
+	}
+	return evaluations
+}
+
+// Target returns what the policy fired for: the product cycle of EOL policies (e.g. "MongoDB Server 2.8") or the
+// image reference of notary policies
+func (e PolicyEvaluation) Target() string {
+	if e.ProductName != "" {
+		return strings.TrimSpace(e.ProductName + " " + e.Cycle)
+	}
+	return e.ImageReference
+}
+
+// Package returns the name and version of the package the policy fired for (e.g. "mongodb@2.8.0"), if any
+func (e PolicyEvaluation) Package() string {
+	if e.PackageName == "" {
+		return ""
+	}
+	return strings.TrimSuffix(e.PackageName+"@"+e.PackageVersion, "@")
+}
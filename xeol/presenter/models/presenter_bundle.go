@@ -5,14 +5,18 @@ import (
 
 	"github.com/xeol-io/xeol/xeol/match"
 	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/policy/types"
 )
 
 type PresenterConfig struct {
-	Matches       match.Matches
-	Packages      []pkg.Package
-	Context       pkg.Context
-	SBOM          *sbom.SBOM
-	AppConfig     interface{}
-	ShowVulnCount bool
-	DBStatus      interface{}
+	Matches           match.Matches
+	IgnoredMatches    []match.IgnoredMatch // matches waived by ignore rules, not part of Matches
+	PolicyEvaluations []types.PolicyEvaluationResult
+	Packages          []pkg.Package
+	Context           pkg.Context
+	SBOM              *sbom.SBOM
+	AppConfig         interface{}
+	ShowVulnCount     bool
+	ShowAll           bool // whether the matches include supported packages (full inventory mode)
+	DBStatus          interface{}
 }
@@ -0,0 +1,138 @@
+package ndjson
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/policy/types"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+const (
+	matchObjectType   = "match"
+	trailerObjectType = "summary"
+)
+
+// matchObject is a single line of the stream, written for every match
+type matchObject struct {
+	Type string `json:"type"`
+	models.Match
+}
+
+// trailer is the last line of the stream, describing the scanned source and the EOL database
+type trailer struct {
+	Type              string                    `json:"type"`
+	Matches           int                       `json:"matches"`
+	IgnoredMatches    []models.IgnoredMatch     `json:"ignoredMatches,omitempty"`
+	PolicyEvaluations []models.PolicyEvaluation `json:"policyEvaluations,omitempty"`
+	Source            interface{}               `json:"source"`
+	Distro            interface{}               `json:"distro"`
+	Descriptor        interface{}               `json:"descriptor"`
+}
+
+// Presenter writes one JSON object per match, followed by a trailer object
+type Presenter struct {
+	matches           match.Matches
+	ignoredMatches    []match.IgnoredMatch
+	policyEvaluations []types.PolicyEvaluationResult
+	packages          []pkg.Package
+	context           pkg.Context
+	appConfig         interface{}
+	dbStatus          interface{}
+}
+
+// NewPresenter is a *Presenter constructor
+func NewPresenter(pb models.PresenterConfig) *Presenter {
+	return &Presenter{
+		matches:           pb.Matches,
+		ignoredMatches:    pb.IgnoredMatches,
+		policyEvaluations: pb.PolicyEvaluations,
+		packages:          pb.Packages,
+		context:           pb.Context,
+		appConfig:         pb.AppConfig,
+		dbStatus:          pb.DBStatus,
+	}
+}
+
+// Present creates a newline-delimited JSON report from already collected matches
+func (pres *Presenter) Present(output io.Writer) error {
+	s := NewStreamer(output)
+	for _, m := range pres.matches.Sorted() {
+		if err := s.WriteMatch(m); err != nil {
+			return err
+		}
+	}
+
+	return s.WriteTrailer(models.PresenterConfig{
+		IgnoredMatches:    pres.ignoredMatches,
+		PolicyEvaluations: pres.policyEvaluations,
+		Packages:          pres.packages,
+		Context:           pres.context,
+		AppConfig:         pres.appConfig,
+		DBStatus:          pres.dbStatus,
+	})
+}
+
+// Streamer writes matches as newline-delimited JSON as soon as they are found. It is safe for concurrent use.
+type Streamer struct {
+	lock    sync.Mutex
+	enc     *json.Encoder
+	written int
+}
+
+// NewStreamer is a *Streamer constructor
+func NewStreamer(output io.Writer) *Streamer {
+	enc := json.NewEncoder(output)
+	// prevent > and < from being escaped in the payload
+	enc.SetEscapeHTML(false)
+	return &Streamer{
+		enc: enc,
+	}
+}
+
+// WriteMatch writes a single match as one line of the stream
+func (s *Streamer) WriteMatch(m match.Match) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err := s.enc.Encode(matchObject{Type: matchObjectType, Match: models.NewMatch(m)}); err != nil {
+		return err
+	}
+	s.written++
+	return nil
+}
+
+// Written returns the number of matches written so far
+func (s *Streamer) Written() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.written
+}
+
+// WriteTrailer ends the stream with an object describing the ignored matches, policy evaluations, source, distro
+// and EOL database status
+func (s *Streamer) WriteTrailer(pb models.PresenterConfig) error {
+	// the document is only used for its ignored matches, policy evaluations, source, distro and descriptor sections,
+	// the matches have been streamed already
+	doc, err := models.NewDocument(pb.Packages, pb.Context, match.NewMatches(), pb.IgnoredMatches, pb.PolicyEvaluations, pb.AppConfig, pb.DBStatus)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.enc.Encode(trailer{
+		Type:              trailerObjectType,
+		Matches:           s.written,
+		IgnoredMatches:    doc.IgnoredMatches,
+		PolicyEvaluations: doc.PolicyEvaluations,
+		Source:            doc.Source,
+		Distro:            doc.Distro,
+		Descriptor:        doc.Descriptor,
+	})
+}
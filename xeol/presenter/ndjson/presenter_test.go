@@ -0,0 +1,62 @@
+package ndjson
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/presenter/internal"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+func TestNDJSONPresenter(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	pb := models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+	}
+
+	pres := NewPresenter(pb)
+
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := internal.Redact(buffer.Bytes())
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestStreamer(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, _, context, _, _ := internal.GenerateAnalysis(t, internal.DirectorySource)
+
+	s := NewStreamer(&buffer)
+	for _, m := range matches.Sorted() {
+		require.NoError(t, s.WriteMatch(m))
+		// every match is a complete line as soon as it is written
+		assert.Equal(t, byte('\n'), buffer.Bytes()[buffer.Len()-1])
+	}
+	require.NoError(t, s.WriteTrailer(models.PresenterConfig{Context: context}))
+
+	var types []string
+	var last map[string]interface{}
+	scanner := bufio.NewScanner(&buffer)
+	for scanner.Scan() {
+		last = make(map[string]interface{})
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &last))
+		types = append(types, last["type"].(string))
+	}
+
+	assert.Equal(t, []string{"match", "match", "match", "summary"}, types)
+	assert.Equal(t, float64(3), last["matches"])
+	assert.NotNil(t, last["source"])
+	assert.Equal(t, 3, s.Written())
+}
@@ -0,0 +1,52 @@
+package openeox
+
+// document is an OpenEoX-style lifecycle document, see https://github.com/oasis-tcs/openeox
+type document struct {
+	Schema     string      `json:"$schema"`
+	Core       core        `json:"core"`
+	Statements []statement `json:"statements"`
+}
+
+// core describes the producer of the document
+type core struct {
+	Publisher   publisher `json:"publisher"`
+	Timestamp   string    `json:"timestamp"`
+	LastUpdated string    `json:"last_updated"`
+}
+
+type publisher struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	URL     string `json:"url"`
+}
+
+// statement holds the EoX facts of a single product release cycle
+type statement struct {
+	Product             product `json:"product"`
+	GeneralAvailability string  `json:"general_availability,omitempty"`
+	EndOfLife           string  `json:"end_of_life,omitempty"`
+	EndOfLifeReached    bool    `json:"end_of_life_reached"`
+	LTS                 bool    `json:"lts"`
+	LatestRelease       string  `json:"latest_release,omitempty"`
+	LatestReleaseDate   string  `json:"latest_release_date,omitempty"`
+	Permalink           string  `json:"permalink,omitempty"`
+}
+
+type product struct {
+	Name         string      `json:"name"`
+	ReleaseCycle string      `json:"release_cycle"`
+	Identifiers  identifiers `json:"identifiers"`
+	Components   []component `json:"components"`
+}
+
+type identifiers struct {
+	PURLs []string `json:"purl"`
+	CPEs  []string `json:"cpe"`
+}
+
+// component is a package found in the scanned source that belongs to the product release cycle
+type component struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Type    string `json:"type"`
+}
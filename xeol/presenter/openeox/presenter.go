@@ -0,0 +1,145 @@
+package openeox
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/xeol-io/xeol/internal"
+	"github.com/xeol-io/xeol/internal/version"
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+const (
+	schemaURL      = "https://docs.oasis-open.org/openeox/core/v1.0/schema/core.json"
+	informationURL = "https://github.com/xeol-io/xeol"
+)
+
+var now = time.Now
+
+// Presenter writes the EOL facts of all matched product cycles as an OpenEoX-style document
+type Presenter struct {
+	matches match.Matches
+	context pkg.Context
+}
+
+// NewPresenter is a *Presenter constructor
+func NewPresenter(pb models.PresenterConfig) *Presenter {
+	return &Presenter{
+		matches: pb.Matches,
+		context: pb.Context,
+	}
+}
+
+// Present creates an OpenEoX-based report
+func (pres *Presenter) Present(output io.Writer) error {
+	timestamp := now().UTC().Format(time.RFC3339)
+	doc := document{
+		Schema: schemaURL,
+		Core: core{
+			Publisher: publisher{
+				Name:    internal.ApplicationName,
+				Version: version.FromBuild().Version,
+				URL:     informationURL,
+			},
+			Timestamp:   timestamp,
+			LastUpdated: timestamp,
+		},
+		Statements: pres.statements(),
+	}
+
+	enc := json.NewEncoder(output)
+	// prevent > and < from being escaped in the payload
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", " ")
+	return enc.Encode(&doc)
+}
+
+// statements returns one statement per matched product/cycle, in the order of the sorted matches
+func (pres *Presenter) statements() []statement {
+	type entry struct {
+		statement statement
+		purls     *strset.Set
+		cpes      *strset.Set
+	}
+
+	var order []string
+	entries := make(map[string]*entry)
+	for _, m := range pres.matches.Sorted() {
+		key := m.Cycle.ProductName + "@" + m.Cycle.ReleaseCycle
+		e, ok := entries[key]
+		if !ok {
+			e = &entry{
+				statement: newStatement(m),
+				purls:     strset.New(),
+				cpes:      strset.New(),
+			}
+			entries[key] = e
+			order = append(order, key)
+		}
+
+		e.statement.Product.Components = append(e.statement.Product.Components, component{
+			Name:    m.Package.Name,
+			Version: m.Package.Version,
+			Type:    string(m.Package.Type),
+		})
+
+		if m.Package.PURL != "" {
+			e.purls.Add(m.Package.PURL)
+		}
+		for _, c := range m.Package.CPEs {
+			e.cpes.Add(c.Attributes.BindToFmtString())
+		}
+		if m.Package.Type == "os" && pres.context.Distro != nil && pres.context.Distro.CPEName != "" {
+			e.cpes.Add(pres.context.Distro.CPEName)
+		}
+	}
+
+	statements := make([]statement, 0, len(order))
+	for _, key := range order {
+		e := entries[key]
+		e.statement.Product.Identifiers = identifiers{
+			PURLs: sortedList(e.purls),
+			CPEs:  sortedList(e.cpes),
+		}
+		statements = append(statements, e.statement)
+	}
+	return statements
+}
+
+func newStatement(m match.Match) statement {
+	s := statement{
+		Product: product{
+			Name:         m.Cycle.ProductName,
+			ReleaseCycle: m.Cycle.ReleaseCycle,
+		},
+		GeneralAvailability: m.Cycle.ReleaseDate,
+		EndOfLifeReached:    m.Cycle.EolBool,
+		LTS:                 m.Cycle.IsLTS(now()),
+		LatestRelease:       m.Cycle.LatestRelease,
+		LatestReleaseDate:   m.Cycle.LatestReleaseDate,
+		Permalink:           m.Cycle.ProductPermalink,
+	}
+
+	// cycles without an EOL date have neither an end of life date nor reached it
+	if !m.Cycle.EolBool && m.Cycle.HasEolDate() {
+		s.EndOfLife = m.Cycle.Eol
+		if eolDate, err := time.Parse(eol.DateLayout, m.Cycle.Eol); err == nil {
+			s.EndOfLifeReached = !now().Before(eolDate)
+		}
+	}
+
+	return s
+}
+
+func sortedList(s *strset.Set) []string {
+	l := s.List()
+	sort.Strings(l)
+	return l
+}
@@ -0,0 +1,102 @@
+package openeox
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/presenter/internal"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+func TestOpenEoXPresenter(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	pb := models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+	}
+
+	now = func() time.Time { return time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	pres := NewPresenter(pb)
+
+	// run presenter
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := buffer.Bytes()
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestEmptyOpenEoXPresenter(t *testing.T) {
+	var buffer bytes.Buffer
+
+	pb := models.PresenterConfig{
+		Matches: match.NewMatches(),
+	}
+
+	now = func() time.Time { return time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	pres := NewPresenter(pb)
+
+	// run presenter
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := buffer.Bytes()
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestNewStatement(t *testing.T) {
+	now = func() time.Time { return time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name            string
+		cycle           eol.Cycle
+		expectedEol     string
+		expectedReached bool
+		expectedLTS     bool
+	}{
+		{
+			name:            "eol date in the past",
+			cycle:           eol.Cycle{ProductName: "Node.js", ReleaseCycle: "10", Eol: "2020-04-30", LTS: "true"},
+			expectedEol:     "2020-04-30",
+			expectedReached: true,
+			expectedLTS:     true,
+		},
+		{
+			name:  "zero eol date reported by the store",
+			cycle: eol.Cycle{ProductName: "Node.js", ReleaseCycle: "16", Eol: "0001-01-01"},
+		},
+		{
+			name:        "lts date in the past",
+			cycle:       eol.Cycle{ProductName: "Node.js", ReleaseCycle: "14", Eol: "2023-04-30", LTS: "2020-10-27"},
+			expectedEol: "2023-04-30",
+			expectedLTS: true,
+		},
+		{
+			name:        "lts date in the future",
+			cycle:       eol.Cycle{ProductName: "Node.js", ReleaseCycle: "16", Eol: "2023-09-11", LTS: "2021-10-26"},
+			expectedEol: "2023-09-11",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newStatement(match.Match{Cycle: tt.cycle})
+			assert.Equal(t, tt.expectedEol, s.EndOfLife)
+			assert.Equal(t, tt.expectedReached, s.EndOfLifeReached)
+			assert.Equal(t, tt.expectedLTS, s.LTS)
+		})
+	}
+}
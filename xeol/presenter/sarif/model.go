@@ -0,0 +1,86 @@
+package sarif
+
+import "github.com/xeol-io/xeol/xeol/presenter/models"
+
+// report is the top level SARIF log object, see https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type report struct {
+	Version string `json:"version"`
+	Schema  string `json:"$schema"`
+	Runs    []run  `json:"runs"`
+}
+
+type run struct {
+	Tool       tool           `json:"tool"`
+	Results    []result       `json:"results"`
+	Properties *runProperties `json:"properties,omitempty"`
+}
+
+// runProperties holds the policy evaluations that are not about a single package (e.g. notary policies)
+type runProperties struct {
+	PolicyEvaluations []models.PolicyEvaluation `json:"policyEvaluations"`
+}
+
+type tool struct {
+	Driver driver `json:"driver"`
+}
+
+type driver struct {
+	Name           string `json:"name"`
+	Version        string `json:"version"`
+	InformationURI string `json:"informationUri"`
+	Rules          []rule `json:"rules"`
+}
+
+type rule struct {
+	ID               string         `json:"id"`
+	Name             string         `json:"name"`
+	ShortDescription message        `json:"shortDescription"`
+	FullDescription  message        `json:"fullDescription"`
+	HelpURI          string         `json:"helpUri,omitempty"`
+	Help             message        `json:"help"`
+	Properties       ruleProperties `json:"properties"`
+}
+
+type ruleProperties struct {
+	Tags []string `json:"tags"`
+}
+
+type result struct {
+	RuleID     string           `json:"ruleId"`
+	Level      string           `json:"level"`
+	Message    message          `json:"message"`
+	Locations  []location       `json:"locations,omitempty"`
+	Properties resultProperties `json:"properties"`
+}
+
+type resultProperties struct {
+	ProductName  string `json:"productName"`
+	ReleaseCycle string `json:"releaseCycle"`
+	EolDate      string `json:"eolDate"`
+	DaysPastEol  *int   `json:"daysPastEol,omitempty"`
+	PURL         string `json:"purl,omitempty"`
+	Status       string `json:"status,omitempty"`
+	// PolicyEvaluations are the policies that fired for the package of the result
+	PolicyEvaluations []models.PolicyEvaluation `json:"policyEvaluations,omitempty"`
+}
+
+type message struct {
+	Text string `json:"text"`
+}
+
+type location struct {
+	PhysicalLocation physicalLocation `json:"physicalLocation"`
+}
+
+type physicalLocation struct {
+	ArtifactLocation artifactLocation `json:"artifactLocation"`
+	Region           region           `json:"region"`
+}
+
+type artifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type region struct {
+	StartLine int `json:"startLine"`
+}
@@ -0,0 +1,285 @@
+package sarif
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	syftSource "github.com/anchore/syft/syft/source"
+
+	"github.com/xeol-io/xeol/internal"
+	"github.com/xeol-io/xeol/internal/version"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+const (
+	sarifVersion   = "2.1.0"
+	sarifSchema    = "https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/schemas/sarif-schema-2.1.0.json"
+	informationURI = "https://github.com/xeol-io/xeol"
+)
+
+var now = time.Now
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9.]+`)
+
+// Presenter is a generic struct for holding fields needed for reporting
+type Presenter struct {
+	matches           match.Matches
+	context           pkg.Context
+	policyEvaluations []models.PolicyEvaluation
+}
+
+// NewPresenter is a *Presenter constructor
+func NewPresenter(pb models.PresenterConfig) *Presenter {
+	return &Presenter{
+		matches:           pb.Matches,
+		context:           pb.Context,
+		policyEvaluations: models.NewPolicyEvaluations(pb.PolicyEvaluations),
+	}
+}
+
+// Present creates a SARIF-based report
+func (pres *Presenter) Present(output io.Writer) error {
+	doc, err := pres.toSarifReport()
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(output)
+	// prevent > and < from being escaped in the payload
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", " ")
+	return enc.Encode(&doc)
+}
+
+func (pres *Presenter) toSarifReport() (*report, error) {
+	rules := make(map[string]rule)
+	results := make([]result, 0)
+
+	// policy evaluations are reported on the results of the package they fired for, the others on the run
+	evaluations := make(map[string][]models.PolicyEvaluation)
+	for _, e := range pres.policyEvaluations {
+		evaluations[e.PackageID] = append(evaluations[e.PackageID], e)
+	}
+	reported := make(map[string]bool)
+
+	for _, m := range pres.matches.Sorted() {
+		id := ruleID(m)
+		if _, ok := rules[id]; !ok {
+			rules[id] = newRule(id, m)
+		}
+
+		r, err := pres.newResult(id, m)
+		if err != nil {
+			return nil, err
+		}
+		if m.Package.ID != "" {
+			r.Properties.PolicyEvaluations = evaluations[string(m.Package.ID)]
+			reported[string(m.Package.ID)] = true
+		}
+		results = append(results, r)
+	}
+
+	var props *runProperties
+	for _, e := range pres.policyEvaluations {
+		if reported[e.PackageID] {
+			continue
+		}
+		if props == nil {
+			props = &runProperties{}
+		}
+		props.PolicyEvaluations = append(props.PolicyEvaluations, e)
+	}
+
+	ruleIDs := make([]string, 0, len(rules))
+	for id := range rules {
+		ruleIDs = append(ruleIDs, id)
+	}
+	sort.Strings(ruleIDs)
+
+	sortedRules := make([]rule, 0, len(rules))
+	for _, id := range ruleIDs {
+		sortedRules = append(sortedRules, rules[id])
+	}
+
+	return &report{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []run{
+			{
+				Tool: tool{
+					Driver: driver{
+						Name:           internal.ApplicationName,
+						Version:        version.FromBuild().Version,
+						InformationURI: informationURI,
+						Rules:          sortedRules,
+					},
+				},
+				Results:    results,
+				Properties: props,
+			},
+		},
+	}, nil
+}
+
+// unknownCycle takes the place of the release cycle of matches whose version does not match any known cycle
+const unknownCycle = "unknown"
+
+// ruleID returns a stable identifier for the product/cycle pair of a match (e.g. "xeol-mongodb-server-3.2")
+func ruleID(m match.Match) string {
+	product := strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(m.Cycle.ProductName), "-"), "-")
+	cycle := m.Cycle.ReleaseCycle
+	if cycle == "" {
+		cycle = unknownCycle
+	}
+	return fmt.Sprintf("%s-%s-%s", internal.ApplicationName, product, cycle)
+}
+
+// newRule creates the rule of the product/cycle pair of a match. The rule describes the lifecycle status of the
+// cycle, which is the same for every match of the pair.
+func newRule(id string, m match.Match) rule {
+	product, cycle := m.Cycle.ProductName, m.Cycle.ReleaseCycle
+	status := m.StatusAt(now())
+
+	var name, description, help string
+	switch status {
+	case match.StatusEol:
+		name = "EndOfLifeSoftware"
+		description = fmt.Sprintf("%s %s is end-of-life", product, cycle)
+		help = description
+		if !m.Cycle.EolBool && m.Cycle.HasEolDate() {
+			help = fmt.Sprintf("%s %s reached end-of-life on %s", product, cycle, m.Cycle.Eol)
+		}
+	case match.StatusEolWithinWindow:
+		name = "SoftwareNearingEndOfLife"
+		description = fmt.Sprintf("%s %s will be end-of-life soon", product, cycle)
+		help = fmt.Sprintf("%s %s will reach end-of-life on %s", product, cycle, m.Cycle.Eol)
+	case match.StatusSupported:
+		name = "SupportedSoftware"
+		description = fmt.Sprintf("%s %s is supported", product, cycle)
+		help = fmt.Sprintf("%s %s is supported until %s", product, cycle, m.Cycle.Eol)
+	case match.StatusUnknownCycle:
+		name = "UnknownSoftwareCycle"
+		description = fmt.Sprintf("%s version does not match any known cycle", product)
+		help = description
+	default:
+		name = "SoftwareWithoutEolDate"
+		description = fmt.Sprintf("%s %s has no end-of-life date", product, cycle)
+		help = description
+	}
+	if m.Cycle.LatestRelease != "" {
+		help = fmt.Sprintf("%s. The latest release is %s.", help, m.Cycle.LatestRelease)
+	}
+
+	return rule{
+		ID:               id,
+		Name:             name,
+		ShortDescription: message{Text: description},
+		FullDescription:  message{Text: description},
+		HelpURI:          m.Cycle.ProductPermalink,
+		Help:             message{Text: help},
+		Properties: ruleProperties{
+			Tags: []string{string(status), internal.ApplicationName},
+		},
+	}
+}
+
+func (pres *Presenter) newResult(id string, m match.Match) (result, error) {
+	props := resultProperties{
+		ProductName:  m.Cycle.ProductName,
+		ReleaseCycle: m.Cycle.ReleaseCycle,
+		PURL:         m.Package.PURL,
+	}
+
+	status := m.StatusAt(now())
+	if m.Status != "" {
+		props.Status = m.StatusLabel(now())
+	}
+
+	level := "error"
+	msg := fmt.Sprintf("%s %s (%s %s) is end-of-life", m.Package.Name, m.Package.Version, m.Cycle.ProductName, m.Cycle.ReleaseCycle)
+
+	switch {
+	case m.Cycle.EolBool:
+		props.EolDate = "true"
+	case !m.Cycle.HasEolDate():
+		level = "note"
+		if status == match.StatusUnknownCycle {
+			msg = fmt.Sprintf("%s %s does not match any known %s cycle", m.Package.Name, m.Package.Version, m.Cycle.ProductName)
+		} else {
+			msg = fmt.Sprintf("%s %s (%s %s) has no end-of-life date", m.Package.Name, m.Package.Version, m.Cycle.ProductName, m.Cycle.ReleaseCycle)
+		}
+	default:
+		days, err := m.Cycle.DaysEol(now())
+		if err != nil {
+			return result{}, fmt.Errorf("unable to parse EOL date for package %s: %w", m.Package.PURL, err)
+		}
+		props.EolDate = m.Cycle.Eol
+		props.DaysPastEol = &days
+		switch status {
+		case match.StatusEol:
+			msg = fmt.Sprintf("%s %s (%s %s) has been end-of-life since %s", m.Package.Name, m.Package.Version, m.Cycle.ProductName, m.Cycle.ReleaseCycle, m.Cycle.Eol)
+		case match.StatusEolWithinWindow:
+			level = "warning"
+			msg = fmt.Sprintf("%s %s (%s %s) will be end-of-life on %s", m.Package.Name, m.Package.Version, m.Cycle.ProductName, m.Cycle.ReleaseCycle, m.Cycle.Eol)
+		default:
+			level = "note"
+			msg = fmt.Sprintf("%s %s (%s %s) is supported until %s", m.Package.Name, m.Package.Version, m.Cycle.ProductName, m.Cycle.ReleaseCycle, m.Cycle.Eol)
+		}
+	}
+
+	return result{
+		RuleID:     id,
+		Level:      level,
+		Message:    message{Text: msg},
+		Locations:  pres.locations(m),
+		Properties: props,
+	}, nil
+}
+
+// locations returns the physical locations of the matched package. OS (distro) matches have no
+// package locations, so we fall back to the scanned file, or leave the location out for other sources.
+func (pres *Presenter) locations(m match.Match) []location {
+	var out []location
+	for _, l := range m.Package.Locations.ToSlice() {
+		out = append(out, newLocation(l.RealPath))
+	}
+
+	if len(out) == 0 {
+		if path := pres.sourcePath(); path != "" {
+			out = append(out, newLocation(path))
+		}
+	}
+	return out
+}
+
+// sourcePath returns the path of the scanned source when it is a file, the only source with a path that code
+// scanning can resolve a location against
+func (pres *Presenter) sourcePath() string {
+	if pres.context.Source == nil {
+		return ""
+	}
+	if m, ok := pres.context.Source.Metadata.(syftSource.FileMetadata); ok {
+		return m.Path
+	}
+	return ""
+}
+
+func newLocation(path string) location {
+	return location{
+		PhysicalLocation: physicalLocation{
+			ArtifactLocation: artifactLocation{
+				URI: strings.TrimPrefix(path, "/"),
+			},
+			Region: region{
+				StartLine: 1,
+			},
+		},
+	}
+}
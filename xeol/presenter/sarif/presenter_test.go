@@ -0,0 +1,175 @@
+package sarif
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/policy/types"
+	"github.com/xeol-io/xeol/xeol/presenter/internal"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+func TestRuleID(t *testing.T) {
+	cases := []struct {
+		name                string
+		cycle               eol.Cycle
+		status              match.Status
+		expected            string
+		expectedDescription string
+	}{
+		{
+			name:                "product with spaces",
+			cycle:               eol.Cycle{ProductName: "MongoDB Server", ReleaseCycle: "3.2", Eol: "2018-09-30"},
+			status:              match.StatusEol,
+			expected:            "xeol-mongodb-server-3.2",
+			expectedDescription: "MongoDB Server 3.2 is end-of-life",
+		},
+		{
+			name:                "product with punctuation",
+			cycle:               eol.Cycle{ProductName: "Node.js", ReleaseCycle: "14", EolBool: true},
+			status:              match.StatusEol,
+			expected:            "xeol-node.js-14",
+			expectedDescription: "Node.js 14 is end-of-life",
+		},
+		{
+			name:                "unknown cycle",
+			cycle:               eol.Cycle{ProductName: "Node.js"},
+			status:              match.StatusUnknownCycle,
+			expected:            "xeol-node.js-unknown",
+			expectedDescription: "Node.js version does not match any known cycle",
+		},
+		{
+			name:                "supported cycle",
+			cycle:               eol.Cycle{ProductName: "Node.js", ReleaseCycle: "22", Eol: "2027-04-30"},
+			status:              match.StatusSupported,
+			expected:            "xeol-node.js-22",
+			expectedDescription: "Node.js 22 is supported",
+		},
+		{
+			name:                "cycle within an EOL window",
+			cycle:               eol.Cycle{ProductName: "Node.js", ReleaseCycle: "20", Eol: "2026-04-30"},
+			status:              match.StatusEolWithinWindow,
+			expected:            "xeol-node.js-20",
+			expectedDescription: "Node.js 20 will be end-of-life soon",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := match.Match{Cycle: tc.cycle, Status: tc.status}
+			id := ruleID(m)
+			assert.Equal(t, tc.expected, id)
+			assert.Equal(t, tc.expectedDescription, newRule(id, m).ShortDescription.Text)
+		})
+	}
+}
+
+func TestSarifImgsPresenter(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	pb := models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+	}
+
+	now = func() time.Time { return time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	pres := NewPresenter(pb)
+
+	// run presenter
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := buffer.Bytes()
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestSarifDirsPresenter(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.DirectorySource)
+
+	pb := models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+	}
+
+	now = func() time.Time { return time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	pres := NewPresenter(pb)
+
+	// run presenter
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := buffer.Bytes()
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestEmptySarifPresenter(t *testing.T) {
+	var buffer bytes.Buffer
+
+	pb := models.PresenterConfig{
+		Matches: match.NewMatches(),
+	}
+
+	pres := NewPresenter(pb)
+
+	// run presenter
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := buffer.Bytes()
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestSarifPresenterPolicyEvaluations(t *testing.T) {
+	m := match.Match{
+		Cycle:   eol.Cycle{ProductName: "MongoDB Server", ReleaseCycle: "2.8", Eol: "2018-07-31"},
+		Package: pkg.Package{ID: "mongodb-id", Name: "mongodb", Version: "2.8.0"},
+	}
+	eolEvaluation := types.EolEvaluationResult{
+		Type:           types.PolicyTypeEol,
+		Action:         types.PolicyActionDeny,
+		PolicyID:       "policy-1",
+		ProductName:    "MongoDB Server",
+		Cycle:          "2.8",
+		PackageID:      "mongodb-id",
+		PackageName:    "mongodb",
+		PackageVersion: "2.8.0",
+	}
+	notaryEvaluation := types.NotaryEvaluationResult{
+		Type:           types.PolicyTypeNotary,
+		Action:         types.PolicyActionDeny,
+		ImageReference: "docker.io/library/alpine:3.18",
+	}
+
+	pres := NewPresenter(models.PresenterConfig{
+		Matches:           match.NewMatches(m),
+		PolicyEvaluations: []types.PolicyEvaluationResult{eolEvaluation, notaryEvaluation},
+	})
+	now = func() time.Time { return time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	doc, err := pres.toSarifReport()
+	require.NoError(t, err)
+	require.Len(t, doc.Runs, 1)
+	require.Len(t, doc.Runs[0].Results, 1)
+
+	evaluations := models.NewPolicyEvaluations([]types.PolicyEvaluationResult{eolEvaluation, notaryEvaluation})
+	assert.Equal(t, evaluations[:1], doc.Runs[0].Results[0].Properties.PolicyEvaluations)
+	require.NotNil(t, doc.Runs[0].Properties)
+	assert.Equal(t, evaluations[1:], doc.Runs[0].Properties.PolicyEvaluations)
+}
@@ -0,0 +1,143 @@
+package spdx
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/anchore/syft/syft/format/common/spdxhelpers"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+
+	"github.com/xeol-io/xeol/internal"
+	"github.com/xeol-io/xeol/internal/version"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+var now = time.Now
+
+// Presenter writes an SPDX 2.3 document of the scanned SBOM, annotated with EOL findings
+type Presenter struct {
+	matches match.Matches
+	sbom    *sbom.SBOM
+}
+
+// eolAnnotation is the content of the comment of each EOL annotation
+type eolAnnotation struct {
+	ProductName  string `json:"productName"`
+	ReleaseCycle string `json:"cycle"`
+	Eol          string `json:"eolDate"`
+	DaysEol      *int   `json:"daysEol,omitempty"`
+	Status       string `json:"status,omitempty"`
+	Window       string `json:"window,omitempty"`
+}
+
+// NewPresenter is a *Presenter constructor
+func NewPresenter(pb models.PresenterConfig) *Presenter {
+	return &Presenter{
+		matches: pb.Matches,
+		sbom:    pb.SBOM,
+	}
+}
+
+// Present creates an SPDX JSON report
+func (pres *Presenter) Present(output io.Writer) error {
+	if pres.sbom == nil {
+		return fmt.Errorf("unable to create SPDX report: no SBOM available for the scanned source")
+	}
+
+	doc := spdxhelpers.ToFormatModel(*pres.sbom)
+	if doc == nil {
+		return fmt.Errorf("unable to convert SBOM to SPDX document")
+	}
+
+	if err := pres.annotate(doc); err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(output)
+	// prevent > and < from being escaped in the payload
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", " ")
+	return enc.Encode(doc)
+}
+
+// annotate adds an annotation to every package that matched an EOL cycle. Distro matches have no package in the
+// document, so they are added to the document annotations.
+func (pres *Presenter) annotate(doc *spdx.Document) error {
+	date := now().UTC().Format(time.RFC3339)
+	annotator := common.Annotator{
+		Annotator:     fmt.Sprintf("%s-%s", internal.ApplicationName, version.FromBuild().Version),
+		AnnotatorType: "Tool",
+	}
+
+	for _, m := range pres.matches.Sorted() {
+		a, err := newAnnotation(m, annotator, date)
+		if err != nil {
+			return err
+		}
+
+		if m.Package.Type == "os" {
+			a.AnnotationSPDXIdentifier = common.MakeDocElementID("", "DOCUMENT")
+			doc.Annotations = append(doc.Annotations, &a)
+			continue
+		}
+
+		p := packageByID(doc, string(m.Package.ID))
+		if p == nil {
+			continue
+		}
+		a.AnnotationSPDXIdentifier = common.MakeDocElementID("", string(p.PackageSPDXIdentifier))
+		p.Annotations = append(p.Annotations, a)
+	}
+	return nil
+}
+
+func newAnnotation(m match.Match, annotator common.Annotator, date string) (spdx.Annotation, error) {
+	cycle := models.NewCycle(m.Cycle)
+	content := eolAnnotation{
+		ProductName:  cycle.ProductName,
+		ReleaseCycle: cycle.ReleaseCycle,
+		Eol:          cycle.Eol,
+		Status:       string(m.Status),
+		Window:       m.Window,
+	}
+
+	if !m.Cycle.EolBool && m.Cycle.HasEolDate() {
+		days, err := m.Cycle.DaysEol(now())
+		if err != nil {
+			return spdx.Annotation{}, fmt.Errorf("unable to parse EOL date for package %s: %w", m.Package.PURL, err)
+		}
+		content.DaysEol = &days
+	}
+
+	comment, err := json.Marshal(content)
+	if err != nil {
+		return spdx.Annotation{}, err
+	}
+
+	return spdx.Annotation{
+		Annotator:         annotator,
+		AnnotationDate:    date,
+		AnnotationType:    "OTHER",
+		AnnotationComment: string(comment),
+	}, nil
+}
+
+// packageByID finds the SPDX package for the given syft package ID, which syft uses as the suffix of every
+// package SPDX identifier.
+func packageByID(doc *spdx.Document, id string) *spdx.Package {
+	if id == "" {
+		return nil
+	}
+	for _, p := range doc.Packages {
+		if strings.HasSuffix(string(p.PackageSPDXIdentifier), "-"+id) {
+			return p
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package spdx
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/presenter/internal"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+func TestSpdxJSONPresenter(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+	s := internal.SBOMFromPackages(t, packages)
+
+	pb := models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+		SBOM:     s,
+	}
+
+	now = func() time.Time { return time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	pres := NewPresenter(pb)
+
+	// run presenter
+	if err := pres.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	actual := internal.Redact(buffer.Bytes())
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestSpdxJSONPresenterAnnotatesPackages(t *testing.T) {
+	var buffer bytes.Buffer
+	matches, packages, _, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	now = func() time.Time { return time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	pres := NewPresenter(models.PresenterConfig{
+		Matches: matches,
+		SBOM:    internal.SBOMFromPackages(t, packages),
+	})
+	require.NoError(t, pres.Present(&buffer))
+
+	var doc struct {
+		Packages []struct {
+			Name        string `json:"name"`
+			Annotations []struct {
+				AnnotationType string `json:"annotationType"`
+				Comment        string `json:"comment"`
+			} `json:"annotations"`
+		} `json:"packages"`
+		Annotations []struct {
+			Comment string `json:"comment"`
+		} `json:"annotations"`
+	}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &doc))
+
+	comments := make(map[string]string)
+	for _, p := range doc.Packages {
+		for _, a := range p.Annotations {
+			assert.Equal(t, "OTHER", a.AnnotationType)
+			comments[p.Name] = a.Comment
+		}
+	}
+
+	assert.Equal(t, map[string]string{
+		"package-1": `{"productName":"MongoDB Server","cycle":"3.2","eolDate":"2018-07-31","daysEol":1614}`,
+		"package-2": `{"productName":"MongoDB Server","cycle":"2.8","eolDate":"true"}`,
+	}, comments)
+
+	require.Len(t, doc.Annotations, 1)
+	assert.Equal(t, `{"productName":"Ubuntu","cycle":"16.04","eolDate":"2021-04-02","daysEol":638}`, doc.Annotations[0].Comment)
+}
@@ -21,6 +21,7 @@ type Presenter struct {
 	matches       match.Matches
 	packages      []pkg.Package
 	showVulnCount bool
+	showAll       bool
 }
 
 // NewPresenter is a *Presenter constructor
@@ -29,6 +30,7 @@ func NewPresenter(pb models.PresenterConfig) *Presenter {
 		matches:       pb.Matches,
 		packages:      pb.Packages,
 		showVulnCount: pb.ShowVulnCount,
+		showAll:       pb.ShowAll,
 	}
 }
 
@@ -36,7 +38,7 @@ func NewPresenter(pb models.PresenterConfig) *Presenter {
 func (pres *Presenter) Present(output io.Writer) error {
 	rows := make([][]string, 0)
 
-	columns := []string{"NAME", "VERSION", "EOL", "DAYS EOL", "TYPE"}
+	columns := []string{"NAME", "VERSION", "EOL", "DAYS EOL", "STATUS", "TYPE"}
 	if pres.showVulnCount {
 		columns = append(columns, "# OF VULNS.")
 	}
@@ -46,7 +48,7 @@ func (pres *Presenter) Present(output io.Writer) error {
 		if m.Package.Name == "" {
 			continue
 		}
-		row, err := createRow(m, pres.showVulnCount)
+		row, err := createRow(m, pres.showVulnCount, pres.showAll)
 
 		if err != nil {
 			return err
@@ -55,7 +57,11 @@ func (pres *Presenter) Present(output io.Writer) error {
 	}
 
 	if len(rows) == 0 {
-		_, err := io.WriteString(output, "✅ no EOL software has been found\n")
+		message := "✅ no EOL software has been found\n"
+		if pres.showAll {
+			message = "no lifecycle-tracked software has been found\n"
+		}
+		_, err := io.WriteString(output, message)
 		return err
 	}
 
@@ -91,18 +97,22 @@ func (pres *Presenter) Present(output io.Writer) error {
 	return nil
 }
 
-func createRow(m match.Match, showVulnCount bool) ([]string, error) {
-	daysEol, err := calculateDaysEol(m)
+func createRow(m match.Match, showVulnCount, showAll bool) ([]string, error) {
+	daysEol, err := calculateDaysEol(m, showAll)
 	if err != nil {
 		return nil, err
 	}
 
 	row := []string{m.Package.Name, m.Package.Version}
-	if m.Cycle.EolBool {
-		row = append(row, "YES", "-", string(m.Package.Type))
-	} else {
-		row = append(row, m.Cycle.Eol, daysEol, string(m.Package.Type))
+	switch {
+	case m.Cycle.EolBool:
+		row = append(row, "YES", "-")
+	case !m.Cycle.HasEolDate():
+		row = append(row, "-", "-")
+	default:
+		row = append(row, m.Cycle.Eol, daysEol)
 	}
+	row = append(row, m.StatusLabel(now()), string(m.Package.Type))
 
 	if showVulnCount {
 		row = append(row, strconv.Itoa(m.VulnCount))
@@ -111,15 +121,22 @@ func createRow(m match.Match, showVulnCount bool) ([]string, error) {
 	return row, nil
 }
 
-func calculateDaysEol(m match.Match) (string, error) {
-	today := now()
-	cycleEolDate, err := time.Parse("2006-01-02", m.Cycle.Eol)
+// calculateDaysEol returns the number of days since the EOL date of the match. Future EOL dates are shown as
+// "in N days" when showing all packages, and as "-" otherwise.
+func calculateDaysEol(m match.Match, showAll bool) (string, error) {
+	if m.Cycle.EolBool || !m.Cycle.HasEolDate() {
+		return "-", nil
+	}
+
+	daysEol, err := m.Cycle.DaysEol(now())
 	if err != nil {
 		return "", fmt.Errorf("unable to parse EOL date for package %s: %w", m.Package.PURL, err)
 	}
 
-	daysEol := int((today.Sub(cycleEolDate).Hours() / 24))
 	if daysEol < 0 {
+		if showAll {
+			return fmt.Sprintf("in %d days", -daysEol), nil
+		}
 		return "-", nil
 	}
 	return strconv.Itoa(daysEol), nil
@@ -43,10 +43,19 @@ func TestCreateRow(t *testing.T) {
 		},
 		Package: pkg,
 	}
+	match3 := match2
+	match3.Status = match.StatusEolWithinWindow
+	match3.Window = "90d"
+	match4 := match.Match{
+		Cycle:   eol.Cycle{ProductName: "MongoDB Server"},
+		Package: pkg,
+		Status:  match.StatusUnknownCycle,
+	}
 
 	cases := []struct {
 		name           string
 		match          match.Match
+		showAll        bool
 		severitySuffix string
 		expectedErr    error
 		expectedRow    []string
@@ -55,13 +64,39 @@ func TestCreateRow(t *testing.T) {
 			name:        "create row for eol",
 			match:       match1,
 			expectedErr: nil,
-			expectedRow: []string{match1.Package.Name, match1.Package.Version, match1.Cycle.Eol, "1614", match1.Package.Type.PackageURLType()},
+			expectedRow: []string{match1.Package.Name, match1.Package.Version, match1.Cycle.Eol, "1614", "eol", match1.Package.Type.PackageURLType()},
+		},
+		{
+			name:        "create row for eol within a window",
+			match:       match3,
+			expectedErr: nil,
+			expectedRow: []string{match3.Package.Name, match3.Package.Version, match3.Cycle.Eol, "-", "eol-within-window (90d)", match3.Package.Type.PackageURLType()},
+		},
+		{
+			name:        "create row for an unknown cycle",
+			match:       match4,
+			expectedErr: nil,
+			expectedRow: []string{match4.Package.Name, match4.Package.Version, "-", "-", "unknown-cycle", match4.Package.Type.PackageURLType()},
 		},
 		{
 			name:        "create row for eol in the future",
 			match:       match2,
 			expectedErr: nil,
-			expectedRow: []string{match2.Package.Name, match2.Package.Version, match2.Cycle.Eol, "-", match2.Package.Type.PackageURLType()},
+			expectedRow: []string{match2.Package.Name, match2.Package.Version, match2.Cycle.Eol, "-", "eol-within-window", match2.Package.Type.PackageURLType()},
+		},
+		{
+			name:        "create row for eol in the future when showing all packages",
+			match:       match2,
+			showAll:     true,
+			expectedErr: nil,
+			expectedRow: []string{match2.Package.Name, match2.Package.Version, match2.Cycle.Eol, "in 732 days", "eol-within-window", match2.Package.Type.PackageURLType()},
+		},
+		{
+			name:        "create row for eol when showing all packages",
+			match:       match1,
+			showAll:     true,
+			expectedErr: nil,
+			expectedRow: []string{match1.Package.Name, match1.Package.Version, match1.Cycle.Eol, "1614", "eol", match1.Package.Type.PackageURLType()},
 		},
 	}
 
@@ -69,7 +104,7 @@ func TestCreateRow(t *testing.T) {
 
 	for _, testCase := range cases {
 		t.Run(testCase.name, func(t *testing.T) {
-			row, err := createRow(testCase.match, false)
+			row, err := createRow(testCase.match, false, testCase.showAll)
 
 			assert.Equal(t, testCase.expectedErr, err)
 			assert.Equal(t, testCase.expectedRow, row)
@@ -0,0 +1,79 @@
+package template
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+var now = time.Now
+
+// eolDate returns the EOL date of a cycle (or a "2006-01-02" date string) as a time.Time, which can be used
+// with the sprig date functions. The zero time is returned for cycles that only have a boolean EOL value.
+func eolDate(value interface{}) (time.Time, error) {
+	date, err := eolDateString(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if _, err := strconv.ParseBool(date); err == nil || date == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(eol.DateLayout, date)
+}
+
+// daysEol returns the number of days since the EOL date of a cycle. The value is negative when the EOL date is
+// in the future, and 0 for cycles that only have a boolean EOL value.
+func daysEol(value interface{}) (int, error) {
+	d, err := eolDate(value)
+	if err != nil || d.IsZero() {
+		return 0, err
+	}
+	return int(now().Sub(d).Hours() / 24), nil
+}
+
+// daysUntilEol returns the number of days left until the EOL date of a cycle (the inverse of daysEol).
+func daysUntilEol(value interface{}) (int, error) {
+	days, err := daysEol(value)
+	return -days, err
+}
+
+// isEol reports whether the cycle is EOL as of today, either by date or by its boolean EOL value.
+func isEol(value interface{}) (bool, error) {
+	date, err := eolDateString(value)
+	if err != nil {
+		return false, err
+	}
+
+	if b, err := strconv.ParseBool(date); err == nil {
+		return b, nil
+	}
+
+	days, err := daysEol(value)
+	if err != nil {
+		return false, err
+	}
+	return days >= 0, nil
+}
+
+func eolDateString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case models.Cycle:
+		return v.Eol, nil
+	case *models.Cycle:
+		return v.Eol, nil
+	case eol.Cycle:
+		if v.EolBool {
+			return "true", nil
+		}
+		return v.Eol, nil
+	case string:
+		return v, nil
+	default:
+		return "", fmt.Errorf("unsupported value for EOL date: %T", value)
+	}
+}
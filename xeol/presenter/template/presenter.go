@@ -0,0 +1,101 @@
+package template
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+	"github.com/xeol-io/xeol/xeol/policy/types"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+// Presenter is an implementation of presenter.Presenter that formats output according to a user-provided Go text template.
+type Presenter struct {
+	matches            match.Matches
+	ignoredMatches     []match.IgnoredMatch
+	policyEvaluations  []types.PolicyEvaluationResult
+	packages           []pkg.Package
+	context            pkg.Context
+	appConfig          interface{}
+	dbStatus           interface{}
+	pathToTemplateFile string
+}
+
+// NewPresenter returns a new template.Presenter.
+func NewPresenter(pb models.PresenterConfig, templateFile string) *Presenter {
+	return &Presenter{
+		matches:            pb.Matches,
+		ignoredMatches:     pb.IgnoredMatches,
+		policyEvaluations:  pb.PolicyEvaluations,
+		packages:           pb.Packages,
+		context:            pb.Context,
+		appConfig:          pb.AppConfig,
+		dbStatus:           pb.DBStatus,
+		pathToTemplateFile: templateFile,
+	}
+}
+
+// Present creates output using a user-supplied Go template.
+func (pres *Presenter) Present(output io.Writer) error {
+	expandedPathToTemplateFile, err := homedir.Expand(pres.pathToTemplateFile)
+	if err != nil {
+		return fmt.Errorf("unable to expand path %q: %w", pres.pathToTemplateFile, err)
+	}
+
+	templateContents, err := os.ReadFile(expandedPathToTemplateFile)
+	if err != nil {
+		return fmt.Errorf("unable to get output template: %w", err)
+	}
+
+	templateName := expandedPathToTemplateFile
+	tmpl, err := template.New(templateName).Funcs(FuncMap).Parse(string(templateContents))
+	if err != nil {
+		return fmt.Errorf("unable to parse template: %w", err)
+	}
+
+	document, err := models.NewDocument(pres.packages, pres.context, pres.matches, pres.ignoredMatches, pres.policyEvaluations, pres.appConfig, pres.dbStatus)
+	if err != nil {
+		return err
+	}
+
+	err = tmpl.Execute(output, document)
+	if err != nil {
+		return fmt.Errorf("unable to execute supplied template: %w", err)
+	}
+
+	return nil
+}
+
+// FuncMap is a function that returns template.FuncMap with custom functions available to template authors.
+var FuncMap = func() template.FuncMap {
+	f := sprig.TxtFuncMap()
+	// do not allow templates to read the environment, which may contain secrets (e.g. the xeol.io API key)
+	delete(f, "env")
+	delete(f, "expandenv")
+
+	f["getLastIndex"] = func(collection interface{}) int {
+		if v := reflect.ValueOf(collection); v.Kind() == reflect.Slice {
+			return v.Len() - 1
+		}
+
+		return 0
+	}
+	// Checks if a field is defined
+	f["hasField"] = func(obj interface{}, field string) bool {
+		t := reflect.TypeOf(obj)
+		_, ok := t.FieldByName(field)
+		return ok
+	}
+	f["eolDate"] = eolDate
+	f["daysEol"] = daysEol
+	f["daysUntilEol"] = daysUntilEol
+	f["isEol"] = isEol
+	return f
+}()
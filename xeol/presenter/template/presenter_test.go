@@ -0,0 +1,119 @@
+package template
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/presenter/internal"
+	"github.com/xeol-io/xeol/xeol/presenter/models"
+)
+
+func TestPresenter_Present(t *testing.T) {
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	workingDirectory, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	templateFilePath := filepath.Join(workingDirectory, "test-fixtures", "test.template")
+
+	pb := models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+	}
+
+	now = func() time.Time { return time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	templatePresenter := NewPresenter(pb, templateFilePath)
+
+	var buffer bytes.Buffer
+	if err := templatePresenter.Present(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	actual := buffer.Bytes()
+
+	snaps.MatchSnapshot(t, actual)
+}
+
+func TestPresenter_MissingTemplate(t *testing.T) {
+	matches, packages, context, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	pb := models.PresenterConfig{
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+	}
+
+	templatePresenter := NewPresenter(pb, filepath.Join("test-fixtures", "does-not-exist.template"))
+
+	var buffer bytes.Buffer
+	assert.Error(t, templatePresenter.Present(&buffer))
+}
+
+func TestDaysEol(t *testing.T) {
+	now = func() time.Time { return time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	cases := []struct {
+		name     string
+		value    interface{}
+		expected int
+		isEol    bool
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "past eol date",
+			value:    models.Cycle{Eol: "2020-12-01"},
+			expected: 30,
+			isEol:    true,
+			wantErr:  require.NoError,
+		},
+		{
+			name:     "future eol date",
+			value:    eol.Cycle{Eol: "2021-01-10"},
+			expected: -10,
+			isEol:    false,
+			wantErr:  require.NoError,
+		},
+		{
+			name:     "boolean eol",
+			value:    models.Cycle{Eol: "true"},
+			expected: 0,
+			isEol:    true,
+			wantErr:  require.NoError,
+		},
+		{
+			name:     "date string",
+			value:    "2020-12-30",
+			expected: 1,
+			isEol:    true,
+			wantErr:  require.NoError,
+		},
+		{
+			name:    "unsupported type",
+			value:   42,
+			wantErr: require.Error,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			days, err := daysEol(tc.value)
+			tc.wantErr(t, err)
+			assert.Equal(t, tc.expected, days)
+
+			isEol, err := isEol(tc.value)
+			tc.wantErr(t, err)
+			assert.Equal(t, tc.isEol, isEol)
+		})
+	}
+}
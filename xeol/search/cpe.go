@@ -0,0 +1,41 @@
+package search
+
+import (
+	"time"
+
+	"github.com/xeol-io/xeol/internal/log"
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+	"github.com/xeol-io/xeol/xeol/pkg"
+)
+
+// ByPackageCPE matches a package against the cycles of the first of its CPEs that is known to the store. Packages
+// whose version does not match any of the cycles are matched with StatusUnknownCycle.
+func ByPackageCPE(store eol.Provider, p pkg.Package, matcherType match.MatcherType, eolMatchDate time.Time) (match.Match, error) {
+	cycles, packageCPE, err := store.GetByPackageCpe(p)
+	if err != nil {
+		return match.Match{}, err
+	}
+	if len(cycles) < 1 {
+		return match.Match{}, nil
+	}
+
+	log.Debugf("attempting to match package %s with cpe %s", p, packageCPE)
+	cycle, err := cycleMatch(p.Version, p.Type, cycles)
+	if err != nil {
+		log.Warnf("failed to match cycle for package %s: %v", p, err)
+	}
+
+	var vulnCount int
+	if p.PURL != "" {
+		vulnCount, err = store.GetVulnCount(p)
+		if err != nil {
+			log.Warnf("failed to get vulnerability count for package %s: %v", p, err)
+			vulnCount = 0
+		}
+	}
+
+	return newMatch(cycle, cycles, p, vulnCount, match.Details{
+		newDetail(match.CPEMethod, matcherType, packageCPE, p.Version, p.Type, cycles, cycle, eolMatchDate),
+	}), nil
+}
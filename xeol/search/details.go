@@ -0,0 +1,48 @@
+package search
+
+import (
+	"time"
+
+	syftPkg "github.com/anchore/syft/syft/pkg"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/match"
+)
+
+// Parameters are the values used to search the EOL database for a package or distro
+type Parameters struct {
+	Query             string `json:"query"` // the short PURL or CPE queried
+	Version           string `json:"version"`
+	NormalizedVersion string `json:"normalizedVersion"` // the version compared to the release cycles
+	MatchDate         string `json:"matchDate"`         // the date a cycle must be EOL by to match
+}
+
+// Result is what was found in the EOL database for the search parameters
+type Result struct {
+	CandidateCycles []string `json:"candidateCycles"` // all release cycles known for the query
+	Cycle           string   `json:"cycle"`           // the release cycle that matched
+	ProductName     string   `json:"productName"`
+}
+
+func newDetail(method match.Method, matcherType match.MatcherType, query, version string, t syftPkg.Type, cycles []eol.Cycle, matched eol.Cycle, eolMatchDate time.Time) match.Detail {
+	candidates := make([]string, 0, len(cycles))
+	for _, c := range cycles {
+		candidates = append(candidates, c.ReleaseCycle)
+	}
+
+	return match.Detail{
+		Type:    method,
+		Matcher: matcherType,
+		SearchedBy: Parameters{
+			Query:             query,
+			Version:           version,
+			NormalizedVersion: normalizeVersion(version, t),
+			MatchDate:         eolMatchDate.Format(eol.DateLayout),
+		},
+		Found: Result{
+			CandidateCycles: candidates,
+			Cycle:           matched.ReleaseCycle,
+			ProductName:     matched.ProductName,
+		},
+	}
+}
@@ -7,14 +7,18 @@ import (
 
 	"github.com/Masterminds/semver"
 	"github.com/anchore/syft/syft/linux"
+	syftPkg "github.com/anchore/syft/syft/pkg"
 
 	"github.com/xeol-io/xeol/internal/log"
+	"github.com/xeol-io/xeol/internal/purl"
 	"github.com/xeol-io/xeol/xeol/eol"
 	"github.com/xeol-io/xeol/xeol/match"
 	"github.com/xeol-io/xeol/xeol/pkg"
 )
 
-func ByPackagePURL(store eol.Provider, p pkg.Package, _ match.MatcherType, eolMatchDate time.Time) (match.Match, error) {
+// ByPackagePURL matches a package against the cycles of its (short) PURL. Packages of a known product whose version
+// does not match any of the cycles are matched with StatusUnknownCycle.
+func ByPackagePURL(store eol.Provider, p pkg.Package, matcherType match.MatcherType, eolMatchDate time.Time) (match.Match, error) {
 	cycles, err := store.GetByPackagePurl(p)
 	if err != nil {
 		return match.Match{}, err
@@ -23,28 +27,33 @@ func ByPackagePURL(store eol.Provider, p pkg.Package, _ match.MatcherType, eolMa
 		return match.Match{}, nil
 	}
 
-	cycle, err := cycleMatch(p.Version, cycles, eolMatchDate)
+	cycle, err := cycleMatch(p.Version, p.Type, cycles)
 	if err != nil {
 		log.Warnf("failed to match cycle for package %s: %v", p, err)
-		return match.Match{}, nil
 	}
 
-	if (cycle != eol.Cycle{}) {
-		vulnCount, err := store.GetVulnCount(p)
-		if err != nil {
-			log.Warnf("failed to get vulnerability count for package %s: %v", p, err)
-			vulnCount = 0
-		}
+	vulnCount, err := store.GetVulnCount(p)
+	if err != nil {
+		log.Warnf("failed to get vulnerability count for package %s: %v", p, err)
+		vulnCount = 0
+	}
+
+	return newMatch(cycle, cycles, p, vulnCount, match.Details{
+		newDetail(match.PURLMethod, matcherType, shortPurl(p), p.Version, p.Type, cycles, cycle, eolMatchDate),
+	}), nil
+}
 
-		return match.Match{
-			Cycle:     cycle,
-			Package:   p,
-			VulnCount: vulnCount,
-		}, nil
+// shortPurl returns the PURL of the package without its version and qualifiers, as queried in the EOL database
+func shortPurl(p pkg.Package) string {
+	short, err := purl.ShortPurl(p)
+	if err != nil {
+		return p.PURL
 	}
-	return match.Match{}, nil
+	return short
 }
 
+// ByDistroCpe matches the distro against the cycles of its CPE. Distros whose version does not match any of the
+// cycles are matched with StatusUnknownCycle.
 func ByDistroCpe(store eol.Provider, distro *linux.Release, eolMatchDate time.Time) (match.Match, string, error) {
 	version, cycles, distroCPE, err := store.GetByDistroCpe(distro)
 	if err != nil {
@@ -55,25 +64,41 @@ func ByDistroCpe(store eol.Provider, distro *linux.Release, eolMatchDate time.Ti
 	}
 
 	log.Debugf("attempting to match distro %s with version %s", distro.Name, version)
-	cycle, err := cycleMatch(version, cycles, eolMatchDate)
+	cycle, err := cycleMatch(version, "", cycles)
 	if err != nil {
 		log.Warnf("failed to match cycle for distro %s: %v", distro.Name, err)
-		return match.Match{}, "", nil
 	}
 
-	if (cycle != eol.Cycle{}) {
-		return match.Match{
-			Cycle: cycle,
-			Package: pkg.Package{
-				Name:    distro.Name,
-				Version: version,
-				Type:    "os",
-			},
-		}, distroCPE, nil
+	p := pkg.Package{
+		Name:    distro.Name,
+		Version: version,
+		Type:    "os",
 	}
+	m := newMatch(cycle, cycles, p, 0, match.Details{
+		newDetail(match.DistroCPEMethod, match.DistroMatcher, distroCPE, version, "", cycles, cycle, eolMatchDate),
+	})
+	m.Method = match.DistroCPEMethod
+	return m, distroCPE, nil
+}
 
-	log.Debugf("matched cycle for distro %s: %v", distro.Name, version)
-	return match.Match{}, "", nil
+// newMatch creates a match for the matched cycle, or a StatusUnknownCycle match for the product of the candidate
+// cycles when no cycle was matched
+func newMatch(cycle eol.Cycle, cycles []eol.Cycle, p pkg.Package, vulnCount int, details match.Details) match.Match {
+	m := match.Match{
+		Cycle:     cycle,
+		Package:   p,
+		VulnCount: vulnCount,
+		Method:    details[0].Type,
+		Details:   details,
+	}
+	if (cycle == eol.Cycle{}) {
+		m.Cycle = eol.Cycle{
+			ProductName:      cycles[0].ProductName,
+			ProductPermalink: cycles[0].ProductPermalink,
+		}
+		m.Status = match.StatusUnknownCycle
+	}
+	return m
 }
 
 // normalizeSemver returns the major.minor.patch portion of a semver string
@@ -155,31 +180,12 @@ func returnMatchingCycle(version string, cycles []eol.Cycle) (eol.Cycle, error)
 	return eol.Cycle{}, nil
 }
 
-func cycleMatch(version string, cycles []eol.Cycle, eolMatchDate time.Time) (eol.Cycle, error) {
-	cycle, err := returnMatchingCycle(version, cycles)
+// cycleMatch returns the cycle matching the version of a package of the given type, regardless of its EOL date
+func cycleMatch(version string, t syftPkg.Type, cycles []eol.Cycle) (eol.Cycle, error) {
+	cycle, err := returnMatchingCycle(normalizeVersion(version, t), cycles)
 	if err != nil {
-		log.Debugf("Error matching cycle for %s: %v", err, err)
+		log.Debugf("Error matching cycle for %s: %v", version, err)
 		return eol.Cycle{}, err
 	}
-
-	if cycle == (eol.Cycle{}) {
-		return cycle, nil
-	}
-
-	// return the cycle if it is boolean EOL
-	if cycle.EolBool {
-		return cycle, nil
-	}
-
-	// return the cycle if the EOL date is after the match date
-	cycleEolDate, err := time.Parse("2006-01-02", cycle.Eol)
-	if err != nil {
-		log.Debugf("error parsing cycle eol date '%s' for %s: %s", cycle.Eol, err)
-		return eol.Cycle{}, err
-	}
-
-	if eolMatchDate.After(cycleEolDate) {
-		return cycle, nil
-	}
-	return eol.Cycle{}, nil
+	return cycle, nil
 }
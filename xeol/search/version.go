@@ -0,0 +1,110 @@
+package search
+
+import (
+	"regexp"
+	"strings"
+
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+// versionScheme extracts the release portion of a package version (e.g. 2.4.52 out of the deb version
+// 1:2.4.52-1ubuntu4) according to the versioning rules of an ecosystem, so it can be compared to release cycles.
+type versionScheme func(version string) string
+
+var (
+	// the leading numeric portion of a version, up to major.minor.patch
+	releaseRe = regexp.MustCompile(`^v?(\d+(?:\.\d+){0,2})`)
+	// the -r<n> package revision of alpine packages, e.g. 3.0.8-r3
+	apkRevisionRe = regexp.MustCompile(`-r\d+$`)
+	// the N! epoch of python versions, e.g. 1!2.0.0
+	pep440EpochRe = regexp.MustCompile(`^\d+!`)
+	// the legacy 1.<major>.0[_<update>] form of java 5-8 versions, e.g. 1.8.0_352-b08
+	javaLegacyRe = regexp.MustCompile(`^1\.([5-8])\.0(?:_(\d+))?[-_]`)
+)
+
+var versionSchemes = map[syftPkg.Type]versionScheme{
+	syftPkg.DebPkg:    debVersion,
+	syftPkg.RpmPkg:    rpmVersion,
+	syftPkg.ApkPkg:    apkVersion,
+	syftPkg.PythonPkg: pep440Version,
+	syftPkg.JavaPkg:   mavenVersion,
+	syftPkg.BinaryPkg: binaryVersion,
+}
+
+// normalizeVersion returns the version of a package of the given type in a form that can be matched against
+// release cycles. Unknown package types fall back to the generic semver normalization.
+func normalizeVersion(version string, t syftPkg.Type) string {
+	scheme, ok := versionSchemes[t]
+	if !ok {
+		return normalizeSemver(version)
+	}
+	return scheme(version)
+}
+
+// debVersion handles [epoch:]upstream_version[-debian_revision]
+func debVersion(version string) string {
+	version = stripEpoch(version)
+	if i := strings.LastIndex(version, "-"); i > 0 {
+		version = version[:i]
+	}
+	return release(version)
+}
+
+// rpmVersion handles [epoch:]version[-release]
+func rpmVersion(version string) string {
+	version = stripEpoch(version)
+	if i := strings.LastIndex(version, "-"); i > 0 {
+		version = version[:i]
+	}
+	return release(version)
+}
+
+// apkVersion handles version[_suffix][-r<revision>]
+func apkVersion(version string) string {
+	version = apkRevisionRe.ReplaceAllString(version, "")
+	if i := strings.Index(version, "_"); i > 0 {
+		version = version[:i]
+	}
+	return release(version)
+}
+
+// pep440Version handles [N!]N(.N)*[{a|b|rc}N][.postN][.devN][+local]
+func pep440Version(version string) string {
+	version = pep440EpochRe.ReplaceAllString(version, "")
+	if i := strings.Index(version, "+"); i > 0 {
+		version = version[:i]
+	}
+	return release(version)
+}
+
+// mavenVersion handles versions with qualifiers, e.g. 2.0.4.RELEASE, 5.3.20.Final or 1.2-SNAPSHOT
+func mavenVersion(version string) string {
+	return release(version)
+}
+
+// binaryVersion handles versions found by the binary classifiers, which are mostly semver-like except for the
+// legacy form of java runtimes (1.8.0_352-b08 is java 8 update 352)
+func binaryVersion(version string) string {
+	if m := javaLegacyRe.FindStringSubmatch(version); m != nil {
+		version = m[1] + ".0"
+		if m[2] != "" {
+			version += "." + m[2]
+		}
+	}
+	return normalizeSemver(version)
+}
+
+func stripEpoch(version string) string {
+	if i := strings.Index(version, ":"); i > 0 {
+		return version[i+1:]
+	}
+	return version
+}
+
+// release returns the leading major[.minor[.patch]] portion of a version, or the version itself when there is none
+func release(version string) string {
+	if m := releaseRe.FindStringSubmatch(version); m != nil {
+		return m[1]
+	}
+	return version
+}
@@ -0,0 +1,97 @@
+package search
+
+import (
+	"testing"
+
+	syftPkg "github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xeol-io/xeol/xeol/eol"
+)
+
+func TestNormalizeVersion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		version  string
+		pkgType  syftPkg.Type
+		expected string
+	}{
+		{name: "deb with epoch and revision", version: "1:2.4.52-1ubuntu4", pkgType: syftPkg.DebPkg, expected: "2.4.52"},
+		{name: "deb with tilde revision", version: "3.6.9-1~18.04ubuntu1.13", pkgType: syftPkg.DebPkg, expected: "3.6.9"},
+		{name: "deb with repack suffix", version: "2.4.52+dfsg-1", pkgType: syftPkg.DebPkg, expected: "2.4.52"},
+		{name: "deb without revision", version: "3.2.21", pkgType: syftPkg.DebPkg, expected: "3.2.21"},
+		{name: "rpm with release", version: "2.4.37-43.module+el8.5.0+747+83fae388.3", pkgType: syftPkg.RpmPkg, expected: "2.4.37"},
+		{name: "rpm with epoch", version: "1:1.1.1k-7.el8_6", pkgType: syftPkg.RpmPkg, expected: "1.1.1"},
+		{name: "apk with revision", version: "3.0.8-r3", pkgType: syftPkg.ApkPkg, expected: "3.0.8"},
+		{name: "apk with suffix", version: "8.1_p1-r0", pkgType: syftPkg.ApkPkg, expected: "8.1"},
+		{name: "pep440 pre-release", version: "3.2.0rc1", pkgType: syftPkg.PythonPkg, expected: "3.2.0"},
+		{name: "pep440 with epoch and local", version: "1!2.0.1.post1+ubuntu", pkgType: syftPkg.PythonPkg, expected: "2.0.1"},
+		{name: "pep440 dev release", version: "2.0.dev3", pkgType: syftPkg.PythonPkg, expected: "2.0"},
+		{name: "maven release qualifier", version: "2.0.4.RELEASE", pkgType: syftPkg.JavaPkg, expected: "2.0.4"},
+		{name: "maven snapshot", version: "1.2-SNAPSHOT", pkgType: syftPkg.JavaPkg, expected: "1.2"},
+		{name: "maven milestone", version: "2.7.0-M1", pkgType: syftPkg.JavaPkg, expected: "2.7.0"},
+		{name: "binary java legacy version", version: "1.8.0_352-b08", pkgType: syftPkg.BinaryPkg, expected: "8.0.352"},
+		{name: "binary java legacy version without update", version: "1.8.0-foreman_2022_09_22_15_30-b00", pkgType: syftPkg.BinaryPkg, expected: "8.0"},
+		{name: "binary java version", version: "11.0.17+8-LTS", pkgType: syftPkg.BinaryPkg, expected: "11.0.17+8-LTS"},
+		{name: "binary ruby version", version: "2.7.8p225", pkgType: syftPkg.BinaryPkg, expected: "2.7.8"},
+		{name: "binary busybox version", version: "1.8.0", pkgType: syftPkg.BinaryPkg, expected: "1.8.0"},
+		{name: "unknown type uses semver normalization", version: "2.5.3p105", pkgType: syftPkg.GemPkg, expected: "2.5.3"},
+		{name: "unparseable version is kept", version: "latest", pkgType: syftPkg.DebPkg, expected: "latest"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, normalizeVersion(tc.version, tc.pkgType))
+		})
+	}
+}
+
+func TestReturnMatchingCycleByPackageType(t *testing.T) {
+	cycles := []eol.Cycle{
+		{ProductName: "Apache HTTP Server", ReleaseCycle: "2.2"},
+		{ProductName: "Apache HTTP Server", ReleaseCycle: "2.4"},
+	}
+
+	testCases := []struct {
+		name    string
+		version string
+		pkgType syftPkg.Type
+	}{
+		{name: "deb", version: "1:2.4.52-1ubuntu4", pkgType: syftPkg.DebPkg},
+		{name: "rpm", version: "2.4.37-43.module+el8.5.0+747+83fae388.3", pkgType: syftPkg.RpmPkg},
+		{name: "apk", version: "2.4.57-r0", pkgType: syftPkg.ApkPkg},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cycle, err := returnMatchingCycle(normalizeVersion(tc.version, tc.pkgType), cycles)
+			assert.NoError(t, err)
+			assert.Equal(t, "2.4", cycle.ReleaseCycle)
+		})
+	}
+}
+
+func TestReturnMatchingCycleJavaBinary(t *testing.T) {
+	cycles := []eol.Cycle{
+		{ProductName: "Java", ReleaseCycle: "8"},
+		{ProductName: "Java", ReleaseCycle: "11"},
+		{ProductName: "Java", ReleaseCycle: "17"},
+	}
+
+	testCases := []struct {
+		version  string
+		expected string
+	}{
+		{version: "1.8.0_352-b08", expected: "8"},
+		{version: "11.0.17+8-LTS", expected: "11"},
+		{version: "17.0.9+9", expected: "17"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.version, func(t *testing.T) {
+			cycle, err := returnMatchingCycle(normalizeVersion(tc.version, syftPkg.BinaryPkg), cycles)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, cycle.ReleaseCycle)
+		})
+	}
+}
@@ -2,8 +2,17 @@ package store
 
 import (
 	"github.com/xeol-io/xeol/xeol/eol"
+	"github.com/xeol-io/xeol/xeol/pkg"
 )
 
 type Store struct {
 	eol.Provider
 }
+
+// Prefetch loads the data of the given packages in bulk when the provider supports it
+func (s Store) Prefetch(packages []pkg.Package) error {
+	if p, ok := s.Provider.(eol.Prefetcher); ok {
+		return p.Prefetch(packages)
+	}
+	return nil
+}